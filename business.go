@@ -0,0 +1,168 @@
+package carbon
+
+import (
+	"time"
+)
+
+// HolidayProvider defines a HolidayProvider interface, it reports whether a given day is a holiday.
+// 定义 HolidayProvider 接口，判断给定日期是否是节假日
+type HolidayProvider interface {
+	IsHoliday(c Carbon) bool
+}
+
+// HolidayFunc defines a HolidayFunc type, it is an adapter to use ordinary functions as holiday providers.
+// 定义 HolidayFunc 类型，用于将普通函数作为节假日提供者
+type HolidayFunc func(c Carbon) bool
+
+// IsHoliday implements the interface HolidayProvider for HolidayFunc type.
+// 实现 HolidayProvider 接口
+func (f HolidayFunc) IsHoliday(c Carbon) bool {
+	return f(c)
+}
+
+// MakeUpWorkdayProvider defines a MakeUpWorkdayProvider interface, it is an optional interface of HolidayProvider
+// to report whether a weekend day is a make-up workday(调休).
+// 定义 MakeUpWorkdayProvider 接口，HolidayProvider 的可选接口，用于判断周末是否是调休工作日
+type MakeUpWorkdayProvider interface {
+	IsMakeUpWorkday(c Carbon) bool
+}
+
+// IsBusinessDay reports whether is business day, which is neither a weekend day nor a holiday,
+// a weekend day is a business day only if it is a make-up workday of the holiday provider.
+// 是否是营业日(既不是周末也不是节假日)，周末只有是节假日提供者的调休工作日时才是营业日
+func (c Carbon) IsBusinessDay() bool {
+	if c.IsInvalid() {
+		return false
+	}
+	if c.isWeekendDay(c.ToStdTime().Weekday()) {
+		provider, ok := c.holidays.(MakeUpWorkdayProvider)
+		return ok && provider.IsMakeUpWorkday(c)
+	}
+	return !c.IsHoliday()
+}
+
+// AddBusinessDays adds some business days.
+// N个营业日后
+func (c Carbon) AddBusinessDays(days int) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	// gives up if there is no business day within a year, such as all the days are weekend days
+	for skipped := 0; days > 0; {
+		c = c.AddDays(step)
+		if c.IsBusinessDay() {
+			days, skipped = days-1, 0
+			continue
+		}
+		if skipped++; skipped > DaysPerLeapYear {
+			c.Error = invalidBusinessDayError()
+			return c
+		}
+	}
+	return c
+}
+
+// SubBusinessDays subtracts some business days.
+// N个营业日前
+func (c Carbon) SubBusinessDays(days int) Carbon {
+	return c.AddBusinessDays(-days)
+}
+
+// NextBusinessDay returns the next business day.
+// 下一个营业日
+func (c Carbon) NextBusinessDay() Carbon {
+	return c.AddBusinessDays(1)
+}
+
+// PreviousBusinessDay returns the previous business day.
+// 上一个营业日
+func (c Carbon) PreviousBusinessDay() Carbon {
+	return c.AddBusinessDays(-1)
+}
+
+// DiffInBusinessDays gets the difference in business days, it is the number of business days passed through like AddBusinessDays.
+// 相差多少营业日(与 AddBusinessDays 一致，统计经过的营业日数)
+func (c Carbon) DiffInBusinessDays(carbon ...Carbon) int64 {
+	end := c.Now()
+	if len(carbon) > 0 {
+		end = carbon[0]
+	}
+	if c.IsInvalid() || end.IsInvalid() {
+		return 0
+	}
+	start, stop := c.StartOfDay(), end.SetLocation(c.loc).StartOfDay()
+	days := int64(0)
+	// counts the business days after the start day until the end day if the end time is after the start time
+	for day := start.AddDay(); !day.Gt(stop); day = day.AddDay() {
+		if day.IsBusinessDay() {
+			days++
+		}
+	}
+	// counts the business days from the end day until the day before the start day if the end time is before the start time
+	for day := stop; day.Lt(start); day = day.AddDay() {
+		if day.IsBusinessDay() {
+			days--
+		}
+	}
+	return days
+}
+
+// DiffAbsInBusinessDays gets the difference in business days with absolute value.
+// 相差多少营业日(绝对值)
+func (c Carbon) DiffAbsInBusinessDays(carbon ...Carbon) int64 {
+	return getAbsValue(c.DiffInBusinessDays(carbon...))
+}
+
+// FirstBusinessDayOfMonth returns the first business day of the month.
+// 本月第一个营业日
+func (c Carbon) FirstBusinessDayOfMonth() Carbon {
+	return c.StartOfMonth().rollBusinessDay(1)
+}
+
+// LastBusinessDayOfMonth returns the last business day of the month.
+// 本月最后一个营业日
+func (c Carbon) LastBusinessDayOfMonth() Carbon {
+	return c.EndOfMonth().rollBusinessDay(-1)
+}
+
+// FirstBusinessDayOfQuarter returns the first business day of the quarter.
+// 本季度第一个营业日
+func (c Carbon) FirstBusinessDayOfQuarter() Carbon {
+	return c.StartOfQuarter().rollBusinessDay(1)
+}
+
+// LastBusinessDayOfQuarter returns the last business day of the quarter.
+// 本季度最后一个营业日
+func (c Carbon) LastBusinessDayOfQuarter() Carbon {
+	return c.EndOfQuarter().rollBusinessDay(-1)
+}
+
+// rolls forward or backward day by day until it is a business day.
+// 逐日向前或向后滚动直到营业日
+func (c Carbon) rollBusinessDay(step int) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	// gives up if there is no business day within a year, such as all the days are weekend days
+	for skipped := 0; !c.IsBusinessDay(); skipped++ {
+		if skipped > DaysPerLeapYear {
+			c.Error = invalidBusinessDayError()
+			return c
+		}
+		c = c.AddDays(step)
+	}
+	return c
+}
+
+// reports whether the weekday is a weekend day.
+// 是否是周末
+func (c Carbon) isWeekendDay(day time.Weekday) bool {
+	if c.weekendDays == 0 {
+		return day == time.Saturday || day == time.Sunday
+	}
+	return c.weekendDays&(1<<uint(day)) != 0
+}
@@ -0,0 +1,80 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_IsBusinessDay(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.IsBusinessDay()
+	}
+}
+
+func BenchmarkCarbon_AddBusinessDays(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.AddBusinessDays(10)
+	}
+}
+
+func BenchmarkCarbon_SubBusinessDays(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.SubBusinessDays(10)
+	}
+}
+
+func BenchmarkCarbon_NextBusinessDay(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.NextBusinessDay()
+	}
+}
+
+func BenchmarkCarbon_PreviousBusinessDay(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.PreviousBusinessDay()
+	}
+}
+
+func BenchmarkCarbon_DiffInBusinessDays(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.DiffInBusinessDays(now.AddMonth())
+	}
+}
+
+func BenchmarkCarbon_DiffAbsInBusinessDays(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.DiffAbsInBusinessDays(now.SubMonth())
+	}
+}
+
+func BenchmarkCarbon_FirstBusinessDayOfMonth(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.FirstBusinessDayOfMonth()
+	}
+}
+
+func BenchmarkCarbon_LastBusinessDayOfMonth(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.LastBusinessDayOfMonth()
+	}
+}
+
+func BenchmarkCarbon_FirstBusinessDayOfQuarter(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.FirstBusinessDayOfQuarter()
+	}
+}
+
+func BenchmarkCarbon_LastBusinessDayOfQuarter(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.LastBusinessDayOfQuarter()
+	}
+}
@@ -0,0 +1,190 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// a holiday provider for testing, 2020-10-01 to 2020-10-08 are holidays
+var testHolidays = HolidayFunc(func(c Carbon) bool {
+	return c.BetweenIncludedBoth(Parse("2020-10-01", c.Location()), Parse("2020-10-08 23:59:59", c.Location()))
+})
+
+func TestCarbon_IsBusinessDay(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		expected bool
+	}{
+		0: {Parse(""), false},
+		1: {Parse("2020-08-05"), true},
+		2: {Parse("2020-08-08"), false},
+		3: {Parse("2020-08-09"), false},
+		4: {Parse("2020-08-08").SetWeekendDays(Friday), true},
+		5: {Parse("2020-08-07").SetWeekendDays(Friday), false},
+		6: {Parse("2020-08-09").SetWeekendDays(), true},
+		7: {Parse("2020-10-05").SetHolidayProvider(testHolidays), false},
+		8: {Parse("2020-10-09").SetHolidayProvider(testHolidays), true},
+	}
+
+	for index, test := range tests {
+		c := test.input
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.IsBusinessDay(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_AddBusinessDays(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		days     int
+		expected string
+	}{
+		0: {Parse(""), 1, ""},
+		1: {Parse("2020-08-05 13:14:15"), 0, "2020-08-05 13:14:15"},
+		2: {Parse("2020-08-05 13:14:15"), 1, "2020-08-06 13:14:15"},
+		3: {Parse("2020-08-05 13:14:15"), 3, "2020-08-10 13:14:15"},
+		4: {Parse("2020-08-08 13:14:15"), 1, "2020-08-10 13:14:15"},
+		5: {Parse("2020-08-05 13:14:15"), -3, "2020-07-31 13:14:15"},
+		6: {Parse("2020-09-30").SetHolidayProvider(testHolidays), 1, "2020-10-09 00:00:00"},
+		7: {Parse("2020-08-06").SetWeekendDays(Friday, Saturday), 1, "2020-08-09 00:00:00"},
+	}
+
+	for index, test := range tests {
+		c := test.input.AddBusinessDays(test.days)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_SubBusinessDays(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		days     int
+		expected string
+	}{
+		0: {Parse(""), 1, ""},
+		1: {Parse("2020-08-10"), 1, "2020-08-07"},
+		2: {Parse("2020-08-09"), 1, "2020-08-07"},
+		3: {Parse("2020-10-09").SetHolidayProvider(testHolidays), 1, "2020-09-30"},
+	}
+
+	for index, test := range tests {
+		c := test.input.SubBusinessDays(test.days)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_NextBusinessDay(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"", ""},
+		1: {"2020-08-05", "2020-08-06"},
+		2: {"2020-08-07", "2020-08-10"},
+		3: {"2020-08-08", "2020-08-10"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).NextBusinessDay()
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_PreviousBusinessDay(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"", ""},
+		1: {"2020-08-05", "2020-08-04"},
+		2: {"2020-08-10", "2020-08-07"},
+		3: {"2020-08-09", "2020-08-07"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).PreviousBusinessDay()
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_DiffInBusinessDays(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input1, input2 string
+		expected       int64
+	}{
+		0: {"", "2020-08-05", 0},
+		1: {"2020-08-05", "2020-08-05", 0},
+		2: {"2020-08-05", "2020-08-06", 1},
+		3: {"2020-08-05", "2020-08-12", 5},
+		4: {"2020-08-08", "2020-08-10", 1},
+		5: {"2020-08-07", "2020-08-10", 1},
+		6: {"2020-08-12", "2020-08-05", -5},
+		7: {"2020-08-10", "2020-08-08", 0},
+		8: {"2020-08-11", "2020-08-08", -1},
+		9: {"2020-08-05 23:00:00", "2020-08-06 01:00:00", 1},
+	}
+
+	for index, test := range tests {
+		c1, c2 := Parse(test.input1), Parse(test.input2)
+		assert.Nil(c1.Error)
+		assert.Nil(c2.Error)
+		assert.Equal(test.expected, c1.DiffInBusinessDays(c2), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(getAbsValue(test.expected), c1.DiffAbsInBusinessDays(c2), "Current test index is "+strconv.Itoa(index))
+	}
+
+	for days := -10; days <= 10; days++ {
+		c := Parse("2020-09-26").SetHolidayProvider(testHolidays)
+		assert.Equal(int64(days), c.DiffInBusinessDays(c.AddBusinessDays(days)), "Current days is "+strconv.Itoa(days))
+	}
+}
+
+func TestCarbon_BusinessDayOfMonth(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input                 Carbon
+		expectedFirst         string
+		expectedLast          string
+		expectedFirstQuarter  string
+		expectedLastOfQuarter string
+	}{
+		0: {Parse(""), "", "", "", ""},
+		1: {Parse("2020-08-05"), "2020-08-03 00:00:00", "2020-08-31 23:59:59", "2020-07-01 00:00:00", "2020-09-30 23:59:59"},
+		2: {Parse("2020-02-05"), "2020-02-03 00:00:00", "2020-02-28 23:59:59", "2020-01-01 00:00:00", "2020-03-31 23:59:59"},
+		3: {Parse("2020-10-05").SetHolidayProvider(testHolidays), "2020-10-09 00:00:00", "2020-10-30 23:59:59", "2020-10-09 00:00:00", "2020-12-31 23:59:59"},
+	}
+
+	for index, test := range tests {
+		c := test.input
+		assert.Nil(c.Error)
+		assert.Equal(test.expectedFirst, c.FirstBusinessDayOfMonth().ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expectedLast, c.LastBusinessDayOfMonth().ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expectedFirstQuarter, c.FirstBusinessDayOfQuarter().ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expectedLastOfQuarter, c.LastBusinessDayOfQuarter().ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestError_Business(t *testing.T) {
+	c := Parse("2020-08-05").SetWeekendDays(Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday)
+	assert.NotNil(t, c.AddBusinessDays(1).Error, "It should catch an exception in AddBusinessDays()")
+	assert.NotNil(t, c.FirstBusinessDayOfMonth().Error, "It should catch an exception in FirstBusinessDayOfMonth()")
+	assert.Equal(t, int64(0), Parse("xxx").DiffInBusinessDays(Parse("2020-08-05")), "It should catch an exception in DiffInBusinessDays()")
+}
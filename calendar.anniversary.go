@@ -0,0 +1,178 @@
+package carbon
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	// returns an invalid lunar anniversary error.
+	// 无效的农历周年日错误
+	invalidLunarAnniversaryError = func(month, day int, policy string) error {
+		return fmt.Errorf("%w of lunar anniversary month %d day %d with policy %q, please make sure the month is between 1 and 12, the day is between 1 and 30 and the policy is %q, %q or %q",
+			ErrInvalidDate, month, day, policy, LunarDayClamp, LunarDaySkip, LunarDayNext)
+	}
+)
+
+// LunarAnniversary defines a LunarAnniversary struct, which is a recurring lunar month and day like a birthday or a memorial day.
+// A leap month anniversary falls in the common month of the same number in a year without that leap month,
+// and the thirtieth day in a month of 29 days is handled by the policy.
+// 定义 LunarAnniversary 结构体，即生日、忌日等每年重复的农历月日。闰月的周年日在没有该闰月的年份落在同序号的普通月份，只有 29 天的月份中的三十日按策略处理
+type LunarAnniversary struct {
+	month, day  int    // 农历月、日
+	isLeapMonth bool   // 是否是闰月
+	policy      string // 三十日的处理策略
+	Error       error
+}
+
+// NewLunarAnniversary creates a LunarAnniversary instance from a lunar month and day, the policy defaults to LunarDayClamp.
+// 从农历月、日创建 LunarAnniversary 实例，策略默认为 LunarDayClamp
+func NewLunarAnniversary(month, day int, isLeapMonth bool, policy ...string) LunarAnniversary {
+	a := LunarAnniversary{month: month, day: day, isLeapMonth: isLeapMonth, policy: LunarDayClamp}
+	if len(policy) > 0 {
+		a.policy = policy[0]
+	}
+	if month < 1 || month > MonthsPerYear || day < 1 || day > 30 ||
+		(a.policy != LunarDayClamp && a.policy != LunarDaySkip && a.policy != LunarDayNext) {
+		a.Error = invalidLunarAnniversaryError(month, day, a.policy)
+	}
+	return a
+}
+
+// LunarAnniversary gets the lunar anniversary of the date like a lunar birthday, the policy defaults to LunarDayClamp.
+// 获取该日期的农历周年日，如农历生日，策略默认为 LunarDayClamp
+func (c Carbon) LunarAnniversary(policy ...string) LunarAnniversary {
+	l := c.Lunar()
+	if l.Error != nil {
+		return LunarAnniversary{Error: l.Error}
+	}
+	if l.IsInvalid() {
+		return LunarAnniversary{Error: invalidValueError(c.ToString())}
+	}
+	return NewLunarAnniversary(l.month, l.day, l.isLeapMonth, policy...)
+}
+
+// Next gets the first occurrence after the Carbon instance at midnight in its location,
+// it returns an invalid Carbon instance if there isn't any.
+// 获取给定时间之后的第一个周年日，为当前时区的零点，不存在时返回无效的 Carbon 实例
+func (a LunarAnniversary) Next(c Carbon) Carbon {
+	if a.Error != nil {
+		c.Error = a.Error
+		return c
+	}
+	if c.IsInvalid() {
+		return c
+	}
+	calendar, year := c.getLunarCalendar(), c.Year()-1
+	if year < minYear {
+		year = minYear
+	}
+	for ; year <= maxYear; year++ {
+		if t, ok := a.occurrence(year, calendar, c.loc); ok && t.After(c.time) {
+			c.time = t
+			return c
+		}
+	}
+	c.time = time.Time{}
+	return c
+}
+
+// Prev gets the last occurrence at or before the Carbon instance at midnight in its location,
+// it returns an invalid Carbon instance if there isn't any.
+// 获取给定时间及之前的最后一个周年日，为当前时区的零点，不存在时返回无效的 Carbon 实例
+func (a LunarAnniversary) Prev(c Carbon) Carbon {
+	if a.Error != nil {
+		c.Error = a.Error
+		return c
+	}
+	if c.IsInvalid() {
+		return c
+	}
+	calendar, year := c.getLunarCalendar(), c.Year()
+	if year > maxYear {
+		year = maxYear
+	}
+	for ; year >= minYear; year-- {
+		if t, ok := a.occurrence(year, calendar, c.loc); ok && !t.After(c.time) {
+			c.time = t
+			return c
+		}
+	}
+	c.time = time.Time{}
+	return c
+}
+
+// Between gets all occurrences between the start and end time at midnight in the location of the start time, both times are included.
+// 获取开始时间和结束时间之间的所有周年日(包含两端)，为开始时间所在时区的零点
+func (a LunarAnniversary) Between(start, end Carbon) []Carbon {
+	occurrences := make([]Carbon, 0)
+	if a.Error != nil || start.IsInvalid() || end.IsInvalid() {
+		return occurrences
+	}
+	calendar, from, to := start.getLunarCalendar(), start.Year()-1, end.Year()
+	if from < minYear {
+		from = minYear
+	}
+	if to > maxYear {
+		to = maxYear
+	}
+	for year := from; year <= to; year++ {
+		t, ok := a.occurrence(year, calendar, start.loc)
+		if !ok || t.Before(start.time) {
+			continue
+		}
+		if t.After(end.time) {
+			break
+		}
+		c := start
+		c.time = t
+		occurrences = append(occurrences, c)
+	}
+	return occurrences
+}
+
+// gets the occurrence in the lunar year at midnight in the location, ok is false if it is skipped by the policy.
+// 获取该农历年的周年日，为该时区的零点，被策略跳过时 ok 为 false
+func (a LunarAnniversary) occurrence(year int, calendar lunarCalendar, loc *time.Location) (t time.Time, ok bool) {
+	l := LunarDate{year: year, month: a.month, calendar: calendar}
+	isLeapMonth := a.isLeapMonth && l.LeapMonth() == a.month
+	days := l.getDaysInMonth()
+	if isLeapMonth {
+		days = l.getDaysInLeapMonth()
+	}
+	day, extra := a.day, 0
+	if day > days {
+		switch a.policy {
+		case LunarDaySkip:
+			return
+		case LunarDayNext:
+			day, extra = days, 1
+		default:
+			day = days
+		}
+	}
+	offset, err := getLunarDays(year, a.month, day, isLeapMonth, calendar)
+	if err != nil {
+		return
+	}
+	y, m, d := lunarOrigin.AddDate(0, 0, offset+extra).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc), true
+}
+
+// NominalAge gets the nominal age (虚岁) by the lunar calendar, which is 1 at birth and increases at every lunar new year.
+// 获取虚岁，出生时为 1 岁，每过一个农历新年增加 1 岁
+func (c Carbon) NominalAge() int {
+	if c.IsInvalid() {
+		return 0
+	}
+	now := c
+	now.time = c.Now().time
+	if c.time.After(now.time) {
+		return 0
+	}
+	birth, today := c.Lunar(), now.Lunar()
+	if birth.IsInvalid() || today.IsInvalid() {
+		return 0
+	}
+	return today.year - birth.year + 1
+}
@@ -0,0 +1,37 @@
+package carbon
+
+import "testing"
+
+func BenchmarkNewLunarAnniversary(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		NewLunarAnniversary(8, 15, false)
+	}
+}
+
+func BenchmarkLunarAnniversary_Next(b *testing.B) {
+	a, c := NewLunarAnniversary(8, 15, false), Now()
+	for n := 0; n < b.N; n++ {
+		a.Next(c)
+	}
+}
+
+func BenchmarkLunarAnniversary_Prev(b *testing.B) {
+	a, c := NewLunarAnniversary(8, 15, false), Now()
+	for n := 0; n < b.N; n++ {
+		a.Prev(c)
+	}
+}
+
+func BenchmarkLunarAnniversary_Between(b *testing.B) {
+	a, c := NewLunarAnniversary(8, 15, false), Now()
+	for n := 0; n < b.N; n++ {
+		a.Between(c, c.AddYears(10))
+	}
+}
+
+func BenchmarkCarbon_NominalAge(b *testing.B) {
+	c := Parse("1990-05-20")
+	for n := 0; n < b.N; n++ {
+		c.NominalAge()
+	}
+}
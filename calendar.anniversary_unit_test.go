@@ -0,0 +1,149 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLunarAnniversary_Next(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		month, day  int
+		isLeapMonth bool
+		policy      string
+		input       string
+		expected    string
+	}{
+		0:  {8, 15, false, LunarDayClamp, "", ""},
+		1:  {8, 15, false, LunarDayClamp, "2020-08-05 13:14:15", "2020-10-01 00:00:00"},
+		2:  {8, 15, false, LunarDayClamp, "2020-10-01 00:00:00", "2021-09-21 00:00:00"},
+		3:  {8, 15, false, LunarDayClamp, "2020-09-30 23:59:59", "2020-10-01 00:00:00"},
+		4:  {12, 30, false, LunarDayClamp, "2021-03-01 00:00:00", "2022-01-31 00:00:00"},
+		5:  {12, 30, false, LunarDaySkip, "2021-03-01 00:00:00", "2023-01-21 00:00:00"},
+		6:  {12, 30, false, LunarDayNext, "2021-03-01 00:00:00", "2022-02-01 00:00:00"},
+		7:  {4, 15, true, LunarDayClamp, "2020-01-01 00:00:00", "2020-06-06 00:00:00"}, // 闰四月
+		8:  {4, 15, true, LunarDayClamp, "2020-07-01 00:00:00", "2021-05-26 00:00:00"}, // no leap month
+		9:  {4, 15, false, LunarDayClamp, "2020-01-01 00:00:00", "2020-05-07 00:00:00"},
+		10: {1, 1, false, LunarDayClamp, "2400-06-01 00:00:00", ""},
+	}
+
+	for index, test := range tests {
+		c := NewLunarAnniversary(test.month, test.day, test.isLeapMonth, test.policy).Next(Parse(test.input, PRC))
+		assert.Nil(c.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestLunarAnniversary_Prev(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		month, day  int
+		isLeapMonth bool
+		policy      string
+		input       string
+		expected    string
+	}{
+		0: {8, 15, false, LunarDayClamp, "", ""},
+		1: {8, 15, false, LunarDayClamp, "2020-08-05 13:14:15", "2019-09-13 00:00:00"},
+		2: {8, 15, false, LunarDayClamp, "2020-10-01 00:00:00", "2020-10-01 00:00:00"},
+		3: {8, 15, false, LunarDayClamp, "2020-10-01 13:14:15", "2020-10-01 00:00:00"},
+		4: {12, 30, false, LunarDayClamp, "2023-01-01 00:00:00", "2022-01-31 00:00:00"},
+		5: {12, 30, false, LunarDaySkip, "2023-01-01 00:00:00", "2021-02-11 00:00:00"},
+		6: {12, 30, false, LunarDayNext, "2023-01-01 00:00:00", "2022-02-01 00:00:00"},
+		7: {4, 15, true, LunarDayClamp, "2020-12-31 00:00:00", "2020-06-06 00:00:00"},
+		8: {1, 1, false, LunarDayClamp, "1600-01-01 00:00:00", ""},
+	}
+
+	for index, test := range tests {
+		c := NewLunarAnniversary(test.month, test.day, test.isLeapMonth, test.policy).Prev(Parse(test.input, PRC))
+		assert.Nil(c.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestLunarAnniversary_Between(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		policy   string
+		expected []string
+	}{
+		0: {LunarDayClamp, []string{"2020-01-24", "2021-02-11", "2022-01-31", "2023-01-21", "2024-02-09", "2025-01-28", "2026-02-16"}},
+		1: {LunarDaySkip, []string{"2020-01-24", "2021-02-11", "2023-01-21", "2024-02-09"}},
+		2: {LunarDayNext, []string{"2020-01-24", "2021-02-11", "2022-02-01", "2023-01-21", "2024-02-09", "2025-01-29", "2026-02-17"}},
+	}
+
+	for index, test := range tests {
+		occurrences := NewLunarAnniversary(12, 30, false, test.policy).Between(Parse("2020-01-24", PRC), Parse("2026-12-31", PRC))
+		dates := make([]string, 0, len(occurrences))
+		for _, c := range occurrences {
+			dates = append(dates, c.ToDateString())
+		}
+		assert.Equal(test.expected, dates, "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.Empty(NewLunarAnniversary(12, 30, false).Between(Parse(""), Parse("2026-12-31")))
+	assert.Empty(NewLunarAnniversary(12, 30, false).Between(Parse("2026-12-31"), Parse("2020-01-01")))
+	assert.Equal("2020-06-16 00:00:00 -0400 EDT", NewLunarAnniversary(4, 25, true).Between(Parse("2020-01-01", NewYork), Parse("2020-12-31", NewYork))[0].ToString())
+}
+
+func TestCarbon_LunarAnniversary(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"1985-05-20", "2021-05-12"}, // 四月初一
+		1: {"2020-06-06", "2021-05-26"}, // 闰四月十五 falls in 四月 without a leap month
+		2: {"2020-02-23", "2021-03-13"}, // 二月初一
+	}
+
+	for index, test := range tests {
+		a := Parse(test.input, PRC).LunarAnniversary()
+		assert.Nil(a.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, a.Next(Parse("2021-01-01", PRC)).ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_NominalAge(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		now      string
+		expected int
+	}{
+		0: {"", "2020-08-05", 0},
+		1: {"2020-01-24", "2020-01-24", 1}, // 除夕
+		2: {"2020-01-24", "2020-01-25", 2}, // 春节
+		3: {"2020-01-25", "2020-12-31", 1},
+		4: {"1985-05-20", "2021-02-11", 36},
+		5: {"1985-05-20", "2021-02-12", 37},
+		6: {"2021-01-01", "2020-08-05", 0},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input, PRC)
+		c.SetTestNow(Parse(test.now, PRC))
+		assert.Equal(test.expected, c.NominalAge(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestError_LunarAnniversary(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(NewLunarAnniversary(0, 1, false).Error)
+	assert.NotNil(NewLunarAnniversary(13, 1, false).Error)
+	assert.NotNil(NewLunarAnniversary(1, 31, false).Error)
+	assert.NotNil(NewLunarAnniversary(1, 1, false, "xxx").Error)
+	assert.NotNil(NewLunarAnniversary(1, 1, false, "xxx").Next(Now()).Error)
+	assert.NotNil(NewLunarAnniversary(1, 1, false, "xxx").Prev(Now()).Error)
+	assert.Empty(NewLunarAnniversary(1, 1, false, "xxx").Between(Now(), Tomorrow()))
+	assert.NotNil(Parse("2500-01-01").LunarAnniversary().Error)
+	assert.NotNil(Parse("xxx").LunarAnniversary().Error)
+}
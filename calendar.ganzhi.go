@@ -0,0 +1,119 @@
+package carbon
+
+var (
+	heavenlyStems    = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	earthlyBranches  = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+	stemElements     = []string{"木", "木", "火", "火", "土", "土", "金", "金", "水", "水"}
+	branchElements   = []string{"水", "土", "木", "木", "土", "火", "火", "土", "金", "金", "土", "水"}
+	sexagenaryNaYins = []string{
+		"海中金", "炉中火", "大林木", "路旁土", "剑锋金", "山头火", "涧下水", "城头土", "白蜡金", "杨柳木",
+		"泉中水", "屋上土", "霹雳火", "松柏木", "长流水", "沙中金", "山下火", "平地木", "壁上土", "金箔金",
+		"覆灯火", "天河水", "大驿土", "钗钏金", "桑柘木", "大溪水", "沙中土", "天上火", "石榴木", "大海水",
+	}
+)
+
+// GanZhi defines a GanZhi struct, which is one of the 60 combinations of the heavenly stems and earthly branches.
+// 定义 GanZhi 结构体，即六十甲子之一
+type GanZhi struct {
+	Gan   string // heavenly stem like "甲"
+	Zhi   string // earthly branch like "子"
+	index int    // index in the sexagenary cycle from 0 (甲子) to 59 (癸亥)
+}
+
+// creates a GanZhi instance by the index in the sexagenary cycle.
+// 通过六十甲子序号创建 GanZhi 实例
+func newGanZhi(index int) GanZhi {
+	index = (index%60 + 60) % 60
+	return GanZhi{Gan: heavenlyStems[index%10], Zhi: earthlyBranches[index%12], index: index}
+}
+
+// String outputs a string like "甲子", implement Stringer interface.
+// 输出干支字符串，实现 Stringer 接口
+func (g GanZhi) String() string {
+	return g.Gan + g.Zhi
+}
+
+// WuXing gets the five elements of the heavenly stem and earthly branch like "木水".
+// 获取天干和地支的五行
+func (g GanZhi) WuXing() string {
+	if g.Gan == "" {
+		return ""
+	}
+	return stemElements[g.index%10] + branchElements[g.index%12]
+}
+
+// NaYin gets the nayin of the sexagenary cycle like "海中金".
+// 获取纳音
+func (g GanZhi) NaYin() string {
+	if g.Gan == "" {
+		return ""
+	}
+	return sexagenaryNaYins[g.index/2]
+}
+
+// YearGanZhi gets the year pillar like "庚子", which switches at 立春 (start of spring) rather than the lunar new year.
+// 获取年柱，以立春为界而不是农历新年
+func (l LunarDate) YearGanZhi() (g GanZhi) {
+	if l.IsInvalid() {
+		return
+	}
+	return newGanZhi(l.getGanZhiYear() - 4)
+}
+
+// MonthGanZhi gets the month pillar like "癸未", which switches at the solar terms like 立春 and 惊蛰 rather than the lunar month.
+// 获取月柱，以立春、惊蛰等节为界而不是农历月份
+func (l LunarDate) MonthGanZhi() (g GanZhi) {
+	if l.IsInvalid() {
+		return
+	}
+	c := l.getCarbon()
+	// the month of 寅 begins at 立春 and the month of 丑 begins at 小寒, the terms with odd indexes are not month boundaries
+	month := 10
+	for _, term := range c.SolarTermsInYear(c.Year()) {
+		if term.Index%2 == 0 && term.Carbon.Lte(c) {
+			month = (term.Index/2 + 11) % 12
+		}
+	}
+	stem := ((l.getGanZhiYear()-4)%10 + 10) % 10
+	return newGanZhi(stem%5*12 + 2 + month)
+}
+
+// DayGanZhi gets the day pillar like "庚辰", which switches at midnight.
+// 获取日柱，以午夜为界
+func (l LunarDate) DayGanZhi() (g GanZhi) {
+	if l.IsInvalid() {
+		return
+	}
+	return newGanZhi(l.getJulianDayNumber() + 49)
+}
+
+// HourGanZhi gets the hour pillar like "癸未", the hour of 子 after 23 o'clock belongs to the next day.
+// 获取时柱，23 点后的子时属于次日
+func (l LunarDate) HourGanZhi() (g GanZhi) {
+	if l.IsInvalid() {
+		return
+	}
+	day := l.getJulianDayNumber() + 49
+	if l.hour == 23 {
+		day++
+	}
+	return newGanZhi(day%10%5*12 + (l.hour+1)/2%12)
+}
+
+// gets the gregorian year of the year pillar, which begins at 立春.
+// 获取年柱对应的公历年份，以立春为界
+func (l LunarDate) getGanZhiYear() int {
+	c := l.getCarbon()
+	year := c.Year()
+	if terms := c.SolarTermsInYear(year); len(terms) > 2 && c.Lt(terms[2].Carbon) {
+		year--
+	}
+	return year
+}
+
+// gets the julian day number of the gregorian date.
+// 获取公历日期的儒略日数
+func (l LunarDate) getJulianDayNumber() int {
+	year, month, day := l.getCarbon().Date()
+	return int(getJulianDay(l.carbon.create(year, month, day, 12, 0, 0, 0, UTC).time))
+}
@@ -0,0 +1,45 @@
+package carbon
+
+import "testing"
+
+func BenchmarkLunar_YearGanZhi(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.YearGanZhi()
+	}
+}
+
+func BenchmarkLunar_MonthGanZhi(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.MonthGanZhi()
+	}
+}
+
+func BenchmarkLunar_DayGanZhi(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.DayGanZhi()
+	}
+}
+
+func BenchmarkLunar_HourGanZhi(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.HourGanZhi()
+	}
+}
+
+func BenchmarkGanZhi_WuXing(b *testing.B) {
+	g := Now().Lunar().YearGanZhi()
+	for n := 0; n < b.N; n++ {
+		g.WuXing()
+	}
+}
+
+func BenchmarkGanZhi_NaYin(b *testing.B) {
+	g := Now().Lunar().YearGanZhi()
+	for n := 0; n < b.N; n++ {
+		g.NaYin()
+	}
+}
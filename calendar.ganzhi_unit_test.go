@@ -0,0 +1,79 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLunar_GanZhi(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input string
+		year  string
+		month string
+		day   string
+		hour  string
+	}{
+		0: {"", "", "", "", ""},
+		1: {"2020-08-05 13:14:15", "庚子", "癸未", "庚辰", "癸未"},
+		2: {"2000-01-01 00:00:00", "己卯", "丙子", "戊午", "壬子"},
+		3: {"2020-02-04 17:00:00", "己亥", "丁丑", "丁丑", "己酉"}, // before 立春
+		4: {"2020-02-04 17:10:00", "庚子", "戊寅", "丁丑", "己酉"}, // after 立春
+		5: {"2020-01-10 23:30:00", "己亥", "丁丑", "壬子", "壬子"}, // the hour of 子 belongs to the next day
+		6: {"1984-02-05 00:00:00", "甲子", "丙寅", "己巳", "甲子"},
+		7: {"2021-12-22 00:00:00", "辛丑", "庚子", "甲辰", "甲子"},
+		8: {"2200-08-05 00:00:00", "庚子", "癸未", "癸亥", "壬子"},
+		9: {"2500-08-05 00:00:00", "", "", "", ""},
+	}
+
+	for index, test := range tests {
+		l := Parse(test.input, PRC).Lunar()
+		assert.Equal(test.year, l.YearGanZhi().String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.month, l.MonthGanZhi().String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.day, l.DayGanZhi().String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.hour, l.HourGanZhi().String(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestGanZhi_WuXing(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		index    int
+		expected string
+	}{
+		0: {0, "木水"},
+		1: {16, "金土"},
+		2: {36, "金水"},
+		3: {59, "水水"},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.expected, newGanZhi(test.index).WuXing(), "Current test index is "+strconv.Itoa(index))
+	}
+	assert.Equal("", GanZhi{}.WuXing())
+}
+
+func TestGanZhi_NaYin(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		index    int
+		expected string
+	}{
+		0: {0, "海中金"},
+		1: {1, "海中金"},
+		2: {16, "白蜡金"},
+		3: {36, "壁上土"},
+		4: {59, "大海水"},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.expected, newGanZhi(test.index).NaYin(), "Current test index is "+strconv.Itoa(index))
+	}
+	assert.Equal("", GanZhi{}.NaYin())
+	assert.Equal("癸亥", newGanZhi(-1).String())
+}
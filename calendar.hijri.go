@@ -0,0 +1,558 @@
+package carbon
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	minHijriYear, maxHijriYear         = 1, 9999
+	minUmmAlQuraYear, maxUmmAlQuraYear = 1356, 1500
+
+	// the julian day number of 1 Muharram 1 in the tabular calendar, which is 622-07-16 in the julian calendar
+	// 算术伊斯兰历 1 年 1 月 1 日的儒略日数，即儒略历 622-07-16
+	hijriEpoch = 1948440
+
+	// the julian day number of the unix epoch
+	// unix 纪元的儒略日数
+	unixEpoch = 2440588
+
+	// the Arabic strings which are used if there are no Hijri resources like "hijri_months" in the locale
+	// 区域中没有 "hijri_months" 等伊斯兰历资源时使用的阿拉伯语字符串
+	hijriMonths = []string{"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة", "رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة"}
+
+	// the first days of the months of the Umm al-Qura calendar from 1356-01-01 to 1501-01-01 as days since the unix epoch,
+	// see https://webspace.science.uu.nl/~gent0113/islam/ummalqura.htm
+	// 乌姆库拉历 1356-01-01 至 1501-01-01 每月第一天距 unix 纪元的天数
+	ummAlQuraMonths = []int{
+		-11981, -11952, -11923, -11893, -11864, -11834, -11805, -11775, -11745, -11716, -11687, -11657, // 1356
+		-11628, -11598, -11569, -11539, -11510, -11480, -11451, -11421, -11392, -11362, -11333, -11303, // 1357
+		-11273, -11243, -11213, -11184, -11154, -11125, -11096, -11066, -11037, -11008, -10978, -10948, // 1358
+		-10919, -10889, -10859, -10829, -10800, -10770, -10741, -10712, -10682, -10653, -10624, -10594, // 1359
+		-10565, -10535, -10506, -10476, -10447, -10417, -10388, -10358, -10329, -10299, -10270, -10240, // 1360
+		-10210, -10180, -10151, -10121, -10092, -10062, -10033, -10003, -9974, -9944, -9915, -9885, // 1361
+		-9856, -9826, -9797, -9767, -9738, -9708, -9679, -9649, -9620, -9590, -9561, -9531, // 1362
+		-9502, -9472, -9443, -9413, -9384, -9354, -9325, -9295, -9266, -9236, -9207, -9177, // 1363
+		-9147, -9117, -9088, -9058, -9029, -8999, -8970, -8940, -8912, -8882, -8852, -8822, // 1364
+		-8793, -8763, -8734, -8704, -8675, -8645, -8616, -8586, -8557, -8527, -8498, -8468, // 1365
+		-8438, -8408, -8379, -8349, -8320, -8290, -8261, -8231, -8202, -8172, -8143, -8113, // 1366
+		-8084, -8054, -8025, -7995, -7966, -7936, -7907, -7877, -7848, -7818, -7789, -7759, // 1367
+		-7730, -7700, -7671, -7641, -7612, -7582, -7553, -7523, -7494, -7464, -7435, -7405, // 1368
+		-7375, -7345, -7316, -7286, -7257, -7227, -7198, -7168, -7138, -7109, -7079, -7049, // 1369
+		-7020, -6990, -6961, -6931, -6902, -6872, -6843, -6813, -6784, -6754, -6725, -6695, // 1370
+		-6666, -6636, -6607, -6577, -6548, -6519, -6489, -6460, -6430, -6401, -6371, -6341, // 1371
+		-6311, -6282, -6252, -6223, -6193, -6164, -6134, -6105, -6076, -6046, -6017, -5987, // 1372
+		-5957, -5928, -5898, -5869, -5839, -5810, -5780, -5751, -5721, -5692, -5662, -5633, // 1373
+		-5603, -5573, -5544, -5514, -5485, -5455, -5426, -5396, -5366, -5337, -5308, -5278, // 1374
+		-5248, -5218, -5189, -5159, -5130, -5100, -5071, -5041, -5012, -4983, -4953, -4923, // 1375
+		-4894, -4865, -4835, -4806, -4777, -4747, -4717, -4687, -4658, -4628, -4599, -4569, // 1376
+		-4540, -4510, -4481, -4452, -4422, -4393, -4363, -4334, -4304, -4274, -4245, -4215, // 1377
+		-4185, -4155, -4126, -4096, -4067, -4037, -4008, -3978, -3949, -3919, -3890, -3860, // 1378
+		-3831, -3802, -3772, -3743, -3713, -3684, -3654, -3625, -3595, -3566, -3536, -3507, // 1379
+		-3477, -3447, -3418, -3388, -3359, -3329, -3300, -3270, -3241, -3211, -3182, -3152, // 1380
+		-3123, -3093, -3064, -3034, -3004, -2975, -2945, -2916, -2887, -2857, -2828, -2798, // 1381
+		-2769, -2739, -2710, -2680, -2650, -2621, -2591, -2561, -2532, -2503, -2473, -2444, // 1382
+		-2414, -2385, -2355, -2326, -2296, -2266, -2237, -2207, -2178, -2148, -2119, -2089, // 1383
+		-2060, -2030, -2001, -1971, -1942, -1912, -1883, -1853, -1824, -1794, -1765, -1735, // 1384
+		-1706, -1676, -1647, -1617, -1587, -1558, -1529, -1499, -1470, -1440, -1410, -1380, // 1385
+		-1351, -1321, -1291, -1262, -1233, -1203, -1174, -1144, -1115, -1085, -1056, -1026, // 1386
+		-996, -967, -938, -908, -879, -849, -820, -790, -761, -731, -702, -672, // 1387
+		-642, -613, -583, -553, -524, -494, -465, -435, -406, -376, -347, -317, // 1388
+		-288, -258, -229, -199, -170, -140, -111, -81, -52, -22, 7, 37, // 1389
+		67, 97, 126, 156, 185, 215, 244, 274, 304, 333, 363, 392, // 1390
+		421, 451, 480, 510, 539, 569, 598, 628, 657, 687, 716, 746, // 1391
+		776, 805, 834, 864, 893, 923, 952, 982, 1011, 1041, 1070, 1100, // 1392
+		1130, 1160, 1189, 1219, 1248, 1277, 1306, 1336, 1365, 1395, 1424, 1454, // 1393
+		1484, 1514, 1543, 1573, 1602, 1632, 1661, 1691, 1720, 1749, 1779, 1809, // 1394
+		1838, 1868, 1897, 1927, 1957, 1986, 2016, 2045, 2074, 2104, 2133, 2163, // 1395
+		2192, 2222, 2251, 2281, 2311, 2341, 2370, 2400, 2429, 2458, 2488, 2517, // 1396
+		2547, 2576, 2606, 2635, 2665, 2695, 2724, 2754, 2783, 2813, 2842, 2872, // 1397
+		2901, 2931, 2960, 2990, 3019, 3049, 3078, 3108, 3138, 3167, 3197, 3226, // 1398
+		3256, 3285, 3315, 3344, 3374, 3403, 3433, 3462, 3492, 3521, 3551, 3581, // 1399
+		3610, 3640, 3670, 3699, 3729, 3758, 3787, 3817, 3846, 3876, 3905, 3935, // 1400
+		3965, 3994, 4024, 4053, 4083, 4112, 4142, 4171, 4200, 4230, 4259, 4289, // 1401
+		4318, 4348, 4378, 4408, 4437, 4467, 4496, 4526, 4555, 4584, 4614, 4643, // 1402
+		4673, 4702, 4732, 4762, 4792, 4821, 4851, 4880, 4910, 4939, 4968, 4998, // 1403
+		5027, 5056, 5086, 5116, 5145, 5175, 5205, 5235, 5264, 5294, 5323, 5352, // 1404
+		5382, 5411, 5440, 5470, 5500, 5529, 5559, 5589, 5618, 5648, 5677, 5707, // 1405
+		5736, 5766, 5795, 5825, 5854, 5884, 5913, 5943, 5972, 6002, 6032, 6061, // 1406
+		6091, 6120, 6150, 6179, 6209, 6238, 6268, 6297, 6327, 6356, 6386, 6415, // 1407
+		6445, 6475, 6504, 6534, 6563, 6593, 6622, 6652, 6681, 6710, 6740, 6769, // 1408
+		6799, 6829, 6858, 6888, 6918, 6947, 6977, 7006, 7036, 7065, 7094, 7124, // 1409
+		7153, 7183, 7212, 7242, 7272, 7302, 7331, 7361, 7390, 7420, 7449, 7478, // 1410
+		7508, 7537, 7567, 7596, 7626, 7656, 7685, 7715, 7745, 7774, 7804, 7833, // 1411
+		7862, 7892, 7921, 7950, 7980, 8010, 8039, 8069, 8099, 8129, 8158, 8188, // 1412
+		8217, 8246, 8276, 8305, 8334, 8364, 8394, 8423, 8453, 8483, 8512, 8542, // 1413
+		8572, 8601, 8630, 8660, 8689, 8718, 8748, 8777, 8807, 8837, 8867, 8896, // 1414
+		8926, 8955, 8985, 9014, 9044, 9073, 9102, 9132, 9161, 9191, 9221, 9250, // 1415
+		9280, 9310, 9339, 9369, 9398, 9428, 9457, 9487, 9516, 9545, 9575, 9604, // 1416
+		9634, 9664, 9693, 9723, 9752, 9782, 9812, 9841, 9871, 9900, 9930, 9959, // 1417
+		9988, 10018, 10047, 10077, 10106, 10136, 10166, 10196, 10225, 10255, 10284, 10314, // 1418
+		10343, 10372, 10402, 10431, 10461, 10490, 10520, 10550, 10579, 10609, 10639, 10668, // 1419
+		10698, 10727, 10757, 10786, 10815, 10845, 10874, 10904, 10934, 10964, 10994, 11023, // 1420
+		11053, 11082, 11111, 11141, 11170, 11199, 11228, 11258, 11288, 11318, 11348, 11377, // 1421
+		11407, 11437, 11466, 11495, 11525, 11554, 11583, 11612, 11642, 11672, 11702, 11731, // 1422
+		11761, 11791, 11820, 11850, 11879, 11909, 11938, 11967, 11997, 12026, 12056, 12085, // 1423
+		12115, 12145, 12174, 12204, 12234, 12263, 12293, 12322, 12351, 12381, 12410, 12440, // 1424
+		12469, 12499, 12528, 12558, 12588, 12617, 12647, 12676, 12706, 12736, 12765, 12795, // 1425
+		12824, 12853, 12883, 12912, 12942, 12971, 13001, 13031, 13060, 13090, 13120, 13149, // 1426
+		13179, 13208, 13237, 13267, 13296, 13326, 13355, 13385, 13415, 13444, 13474, 13504, // 1427
+		13533, 13563, 13592, 13621, 13651, 13680, 13709, 13739, 13769, 13799, 13828, 13858, // 1428
+		13888, 13917, 13947, 13976, 14005, 14035, 14064, 14093, 14123, 14153, 14182, 14212, // 1429
+		14242, 14271, 14301, 14331, 14360, 14389, 14419, 14448, 14478, 14507, 14537, 14566, // 1430
+		14596, 14625, 14655, 14685, 14714, 14744, 14773, 14803, 14832, 14862, 14891, 14920, // 1431
+		14950, 14979, 15009, 15039, 15069, 15098, 15128, 15157, 15187, 15216, 15246, 15275, // 1432
+		15304, 15334, 15363, 15393, 15423, 15452, 15482, 15512, 15541, 15571, 15600, 15630, // 1433
+		15659, 15688, 15718, 15747, 15777, 15806, 15836, 15866, 15895, 15925, 15955, 15984, // 1434
+		16013, 16043, 16072, 16102, 16131, 16161, 16190, 16220, 16249, 16279, 16309, 16338, // 1435
+		16368, 16397, 16427, 16456, 16486, 16515, 16545, 16574, 16604, 16633, 16663, 16692, // 1436
+		16722, 16752, 16781, 16811, 16841, 16870, 16899, 16929, 16958, 16988, 17017, 17046, // 1437
+		17076, 17106, 17135, 17165, 17195, 17225, 17254, 17283, 17313, 17342, 17371, 17401, // 1438
+		17430, 17460, 17489, 17519, 17549, 17579, 17608, 17638, 17667, 17697, 17726, 17755, // 1439
+		17785, 17814, 17844, 17873, 17903, 17933, 17963, 17992, 18022, 18051, 18081, 18110, // 1440
+		18139, 18169, 18198, 18228, 18257, 18287, 18317, 18346, 18376, 18406, 18435, 18465, // 1441
+		18494, 18523, 18553, 18582, 18612, 18641, 18671, 18700, 18730, 18760, 18789, 18819, // 1442
+		18848, 18878, 18907, 18937, 18966, 18996, 19025, 19055, 19084, 19114, 19143, 19173, // 1443
+		19203, 19232, 19262, 19291, 19321, 19351, 19380, 19409, 19439, 19468, 19498, 19527, // 1444
+		19557, 19586, 19616, 19646, 19676, 19705, 19735, 19764, 19793, 19823, 19852, 19881, // 1445
+		19911, 19940, 19970, 20000, 20030, 20059, 20089, 20119, 20148, 20177, 20207, 20236, // 1446
+		20265, 20295, 20324, 20354, 20384, 20414, 20443, 20473, 20502, 20532, 20561, 20591, // 1447
+		20620, 20649, 20679, 20708, 20738, 20768, 20797, 20827, 20857, 20886, 20916, 20945, // 1448
+		20975, 21004, 21033, 21063, 21092, 21122, 21151, 21181, 21211, 21240, 21270, 21300, // 1449
+		21329, 21359, 21388, 21418, 21447, 21476, 21506, 21535, 21565, 21594, 21624, 21654, // 1450
+		21683, 21713, 21743, 21772, 21802, 21831, 21860, 21890, 21919, 21949, 21978, 22008, // 1451
+		22037, 22067, 22097, 22127, 22156, 22186, 22215, 22244, 22274, 22303, 22333, 22362, // 1452
+		22392, 22421, 22451, 22481, 22511, 22540, 22569, 22599, 22628, 22658, 22687, 22717, // 1453
+		22746, 22775, 22805, 22835, 22865, 22894, 22924, 22953, 22983, 23012, 23042, 23071, // 1454
+		23101, 23130, 23159, 23189, 23219, 23248, 23278, 23307, 23337, 23367, 23396, 23426, // 1455
+		23455, 23485, 23514, 23543, 23573, 23602, 23632, 23661, 23691, 23721, 23751, 23780, // 1456
+		23810, 23839, 23869, 23898, 23927, 23957, 23986, 24015, 24045, 24075, 24104, 24134, // 1457
+		24164, 24194, 24223, 24253, 24282, 24311, 24341, 24370, 24399, 24429, 24459, 24488, // 1458
+		24518, 24548, 24578, 24607, 24637, 24666, 24695, 24725, 24754, 24783, 24813, 24843, // 1459
+		24872, 24902, 24932, 24961, 24991, 25020, 25050, 25079, 25109, 25138, 25167, 25197, // 1460
+		25227, 25256, 25286, 25315, 25345, 25375, 25404, 25434, 25463, 25493, 25522, 25552, // 1461
+		25581, 25611, 25640, 25670, 25699, 25729, 25758, 25788, 25817, 25847, 25877, 25906, // 1462
+		25936, 25965, 25995, 26024, 26053, 26083, 26112, 26142, 26172, 26201, 26231, 26261, // 1463
+		26290, 26320, 26349, 26379, 26408, 26437, 26467, 26496, 26526, 26555, 26585, 26615, // 1464
+		26645, 26674, 26704, 26733, 26763, 26792, 26821, 26851, 26880, 26909, 26939, 26969, // 1465
+		26999, 27029, 27058, 27088, 27117, 27147, 27176, 27205, 27235, 27264, 27294, 27323, // 1466
+		27353, 27383, 27412, 27442, 27472, 27501, 27531, 27560, 27589, 27619, 27648, 27678, // 1467
+		27707, 27737, 27766, 27796, 27826, 27855, 27885, 27914, 27944, 27973, 28003, 28032, // 1468
+		28062, 28091, 28120, 28150, 28180, 28209, 28239, 28269, 28298, 28328, 28358, 28387, // 1469
+		28416, 28446, 28475, 28504, 28534, 28564, 28593, 28623, 28652, 28682, 28712, 28742, // 1470
+		28771, 28800, 28830, 28859, 28888, 28918, 28947, 28977, 29007, 29036, 29066, 29096, // 1471
+		29125, 29155, 29184, 29214, 29243, 29273, 29302, 29331, 29361, 29390, 29420, 29450, // 1472
+		29479, 29509, 29538, 29568, 29598, 29627, 29657, 29686, 29715, 29745, 29774, 29804, // 1473
+		29833, 29863, 29893, 29922, 29952, 29982, 30011, 30041, 30070, 30099, 30129, 30158, // 1474
+		30188, 30217, 30247, 30276, 30306, 30336, 30366, 30395, 30425, 30454, 30483, 30513, // 1475
+		30542, 30571, 30601, 30630, 30660, 30690, 30720, 30749, 30779, 30809, 30838, 30867, // 1476
+		30897, 30926, 30955, 30985, 31014, 31044, 31074, 31103, 31133, 31163, 31193, 31222, // 1477
+		31251, 31281, 31310, 31339, 31369, 31398, 31428, 31458, 31487, 31517, 31547, 31576, // 1478
+		31606, 31635, 31665, 31694, 31723, 31753, 31782, 31812, 31841, 31871, 31901, 31930, // 1479
+		31960, 31989, 32019, 32049, 32078, 32107, 32137, 32166, 32196, 32225, 32255, 32284, // 1480
+		32314, 32343, 32373, 32403, 32432, 32462, 32492, 32521, 32551, 32580, 32609, 32639, // 1481
+		32668, 32698, 32727, 32757, 32787, 32816, 32846, 32876, 32905, 32935, 32964, 32993, // 1482
+		33023, 33052, 33081, 33111, 33141, 33170, 33200, 33230, 33260, 33289, 33319, 33348, // 1483
+		33377, 33407, 33436, 33465, 33495, 33525, 33554, 33584, 33614, 33643, 33673, 33703, // 1484
+		33732, 33761, 33791, 33820, 33849, 33879, 33909, 33938, 33968, 33997, 34027, 34057, // 1485
+		34087, 34116, 34145, 34175, 34204, 34234, 34263, 34293, 34322, 34352, 34381, 34411, // 1486
+		34441, 34470, 34500, 34529, 34559, 34588, 34618, 34647, 34676, 34706, 34735, 34765, // 1487
+		34795, 34824, 34854, 34884, 34913, 34943, 34972, 35002, 35031, 35060, 35090, 35119, // 1488
+		35149, 35178, 35208, 35238, 35268, 35297, 35327, 35356, 35386, 35415, 35444, 35474, // 1489
+		35503, 35533, 35562, 35592, 35622, 35651, 35681, 35711, 35740, 35770, 35799, 35828, // 1490
+		35858, 35887, 35917, 35946, 35976, 36005, 36035, 36065, 36094, 36124, 36153, 36183, // 1491
+		36213, 36242, 36271, 36301, 36330, 36360, 36389, 36419, 36448, 36478, 36508, 36537, // 1492
+		36567, 36597, 36626, 36655, 36685, 36714, 36744, 36773, 36802, 36832, 36862, 36891, // 1493
+		36921, 36951, 36981, 37010, 37039, 37069, 37098, 37127, 37157, 37186, 37216, 37245, // 1494
+		37275, 37305, 37335, 37364, 37394, 37423, 37453, 37482, 37511, 37541, 37570, 37600, // 1495
+		37629, 37659, 37689, 37719, 37748, 37778, 37807, 37837, 37866, 37895, 37925, 37954, // 1496
+		37984, 38013, 38043, 38073, 38102, 38132, 38162, 38191, 38220, 38250, 38279, 38309, // 1497
+		38338, 38368, 38397, 38427, 38456, 38486, 38516, 38545, 38575, 38604, 38634, 38663, // 1498
+		38693, 38722, 38752, 38781, 38811, 38840, 38870, 38899, 38929, 38958, 38988, 39018, // 1499
+		39047, 39077, 39107, 39136, 39165, 39195, 39224, 39253, 39283, 39312, 39342, 39372, // 1500
+		39402, // 1501
+	}
+
+	// returns an invalid Hijri calendar error.
+	// 无效的伊斯兰历种类错误
+	invalidHijriCalendarError = func(calendar string) error {
+		return fmt.Errorf("%w %q, please make sure the hijri calendar is %q or %q", ErrInvalidCalendar, calendar, HijriUmmAlQura, HijriTabular)
+	}
+
+	// returns an invalid Hijri date error.
+	// 无效的伊斯兰历日期错误
+	invalidHijriDateError = func(year, month, day int, calendar string) error {
+		first, last := getHijriYearRange(calendar)
+		return fmt.Errorf("%w %d-%02d-%02d of hijri calendar, please make sure the date exists and the year is between %d and %d in the %q calendar", ErrInvalidDate, year, month, day, first, last, calendar)
+	}
+)
+
+// HijriDate defines a HijriDate struct, which is a date and time of the Islamic Hijri calendar.
+// 定义 HijriDate 结构体，即伊斯兰历的日期时间
+type HijriDate struct {
+	year, month, day, hour, minute, second int    // 伊斯兰历年、月、日、时、分、秒
+	isInvalid                              bool   // 是否不可利用
+	calendar                               string // 伊斯兰历种类
+	carbon                                 Carbon // 对应的公历时间
+	Error                                  error
+}
+
+// SetHijriCalendar sets the Hijri calendar like "ummalqura" or "tabular".
+// 设置伊斯兰历种类，如 "ummalqura" 或 "tabular"
+func (c Carbon) SetHijriCalendar(calendar string) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if calendar != HijriUmmAlQura && calendar != HijriTabular {
+		c.Error = invalidHijriCalendarError(calendar)
+		return c
+	}
+	c.hijri = calendar
+	return c
+}
+
+// SetHijriCalendar sets the Hijri calendar like "ummalqura" or "tabular".
+// 设置伊斯兰历种类，如 "ummalqura" 或 "tabular"
+func SetHijriCalendar(calendar string) Carbon {
+	return NewCarbon().SetHijriCalendar(calendar)
+}
+
+// gets the Hijri calendar, which is the Umm al-Qura calendar by default.
+// 获取伊斯兰历种类，默认为乌姆库拉历
+func (c Carbon) getHijriCalendar() string {
+	if c.hijri == "" {
+		return HijriUmmAlQura
+	}
+	return c.hijri
+}
+
+// Hijri converts the gregorian calendar to the Hijri calendar in the location of the instance,
+// the Umm al-Qura calendar supports the years from 1356 to 1500 (1937-03-14 to 2077-11-16).
+// 将公历转为当前时区的伊斯兰历，乌姆库拉历支持 1356 年至 1500 年（1937-03-14 至 2077-11-16）
+func (c Carbon) Hijri() (h HijriDate) {
+	if c.IsInvalid() {
+		h.Error = c.Error
+		h.isInvalid = true
+		return
+	}
+	h.calendar = c.getHijriCalendar()
+	t := c.ToStdTime()
+	days := getDaysFromEpoch(t.Date())
+	if h.calendar == HijriUmmAlQura {
+		i := sort.SearchInts(ummAlQuraMonths, days+1) - 1
+		if i < 0 || i >= len(ummAlQuraMonths)-1 {
+			// the tabular date is close enough to report the year
+			h.year, h.month, h.day = getTabularHijriDate(days)
+			h.Error = invalidHijriDateError(h.year, h.month, h.day, h.calendar)
+			h.isInvalid = true
+			return
+		}
+		h.year, h.month, h.day = minUmmAlQuraYear+i/MonthsPerYear, i%MonthsPerYear+1, days-ummAlQuraMonths[i]+1
+	} else {
+		h.year, h.month, h.day = getTabularHijriDate(days)
+		if h.year < minHijriYear || h.year > maxHijriYear {
+			h.Error = invalidHijriDateError(h.year, h.month, h.day, h.calendar)
+			h.isInvalid = true
+			return
+		}
+	}
+	h.hour, h.minute, h.second = t.Clock()
+	h.carbon = c
+	return
+}
+
+// CreateFromHijri creates a Carbon instance from a given Hijri date and time.
+// 从给定的伊斯兰历年、月、日、时、分、秒创建 Carbon 实例
+func (c Carbon) CreateFromHijri(year, month, day, hour, minute, second int, timezone ...string) Carbon {
+	if len(timezone) > 0 {
+		c.loc, c.Error = getLocationByTimezone(timezone[0])
+	}
+	if c.Error != nil {
+		return c
+	}
+	days, err := getHijriDays(year, month, day, c.getHijriCalendar())
+	if err != nil {
+		c.Error = err
+		return c
+	}
+	c.time = time.Date(1970, 1, 1+days, hour, minute, second, 0, c.loc)
+	return c
+}
+
+// CreateFromHijri creates a Carbon instance from a given Hijri date and time.
+// 从给定的伊斯兰历年、月、日、时、分、秒创建 Carbon 实例
+func CreateFromHijri(year, month, day, hour, minute, second int, timezone ...string) Carbon {
+	return NewCarbon().CreateFromHijri(year, month, day, hour, minute, second, timezone...)
+}
+
+// RamadanStart gets the first day of Ramadan of the Hijri year.
+// 获取伊斯兰历年份的斋月第一天
+func (c Carbon) RamadanStart(year int) Carbon {
+	return c.CreateFromHijri(year, 9, 1, 0, 0, 0)
+}
+
+// RamadanEnd gets the last day of Ramadan of the Hijri year.
+// 获取伊斯兰历年份的斋月最后一天
+func (c Carbon) RamadanEnd(year int) Carbon {
+	return c.CreateFromHijri(year, 10, 1, 0, 0, 0).SubDay()
+}
+
+// EidAlFitr gets Eid al-Fitr of the Hijri year, which is the first day of Shawwal.
+// 获取伊斯兰历年份的开斋节，即 Shawwal 月第一天
+func (c Carbon) EidAlFitr(year int) Carbon {
+	return c.CreateFromHijri(year, 10, 1, 0, 0, 0)
+}
+
+// EidAlAdha gets Eid al-Adha of the Hijri year, which is the tenth day of Dhu al-Hijjah.
+// 获取伊斯兰历年份的古尔邦节，即 Dhu al-Hijjah 月第十天
+func (c Carbon) EidAlAdha(year int) Carbon {
+	return c.CreateFromHijri(year, 12, 10, 0, 0, 0)
+}
+
+// IsInvalid reports whether the Hijri date is invalid, the zero value of HijriDate is invalid.
+// 是否是无效的伊斯兰历日期，HijriDate 的零值无效
+func (h HijriDate) IsInvalid() bool {
+	return h.isInvalid || h.year == 0
+}
+
+// ToCarbon converts the Hijri date back to a Carbon instance.
+// 将伊斯兰历日期转换回 Carbon 实例
+func (h HijriDate) ToCarbon() Carbon {
+	if h.IsInvalid() {
+		c := NewCarbon()
+		c.Error = h.Error
+		return c
+	}
+	return h.carbon
+}
+
+// DateTime gets Hijri year, month, day, hour, minute, and second like 1441, 12, 15, 13, 14, 15.
+// 获取伊斯兰历年月日时分秒
+func (h HijriDate) DateTime() (year, month, day, hour, minute, second int) {
+	if h.IsInvalid() {
+		return
+	}
+	return h.year, h.month, h.day, h.hour, h.minute, h.second
+}
+
+// Date gets Hijri year, month and day like 1441, 12, 15.
+// 获取伊斯兰历年月日
+func (h HijriDate) Date() (year, month, day int) {
+	if h.IsInvalid() {
+		return
+	}
+	return h.year, h.month, h.day
+}
+
+// Time gets Hijri hour, minute, and second like 13, 14, 15.
+// 获取伊斯兰历时分秒
+func (h HijriDate) Time() (hour, minute, second int) {
+	if h.IsInvalid() {
+		return
+	}
+	return h.hour, h.minute, h.second
+}
+
+// Year gets Hijri year like 1441.
+// 获取伊斯兰历年
+func (h HijriDate) Year() int {
+	if h.IsInvalid() {
+		return 0
+	}
+	return h.year
+}
+
+// Month gets Hijri month like 12.
+// 获取伊斯兰历月
+func (h HijriDate) Month() int {
+	if h.IsInvalid() {
+		return 0
+	}
+	return h.month
+}
+
+// Day gets Hijri day like 15.
+// 获取伊斯兰历日
+func (h HijriDate) Day() int {
+	if h.IsInvalid() {
+		return 0
+	}
+	return h.day
+}
+
+// DaysInMonth gets total days in Hijri month like 30.
+// 获取伊斯兰历该月总天数
+func (h HijriDate) DaysInMonth() int {
+	if h.IsInvalid() {
+		return 0
+	}
+	return getDaysInHijriMonth(h.year, h.month, h.calendar)
+}
+
+// IsLeapYear reports whether is leap year, which has 355 days.
+// 是否是闰年，闰年有 355 天
+func (h HijriDate) IsLeapYear() bool {
+	if h.IsInvalid() {
+		return false
+	}
+	days := 0
+	for month := 1; month <= MonthsPerYear; month++ {
+		days += getDaysInHijriMonth(h.year, month, h.calendar)
+	}
+	return days == 355
+}
+
+// IsRamadan reports whether is in Ramadan.
+// 是否是斋月
+func (h HijriDate) IsRamadan() bool {
+	if h.IsInvalid() {
+		return false
+	}
+	return h.month == 9
+}
+
+// IsEidAlFitr reports whether is Eid al-Fitr.
+// 是否是开斋节
+func (h HijriDate) IsEidAlFitr() bool {
+	if h.IsInvalid() {
+		return false
+	}
+	return h.month == 10 && h.day == 1
+}
+
+// IsEidAlAdha reports whether is Eid al-Adha.
+// 是否是古尔邦节
+func (h HijriDate) IsEidAlAdha() bool {
+	if h.IsInvalid() {
+		return false
+	}
+	return h.month == 12 && h.day == 10
+}
+
+// ToMonthString outputs a string in Hijri month format like "رمضان" or "Ramadan" in the locale.
+// 获取当前区域的伊斯兰历月份字符串
+func (h HijriDate) ToMonthString() string {
+	if h.IsInvalid() {
+		return ""
+	}
+	return h.carbon.lang.getResources("hijri_months", hijriMonths)[h.month-1]
+}
+
+// ToDateString outputs a string in Hijri date format like "15 Dhu al-Hijjah 1441" in the locale.
+// 获取当前区域的伊斯兰历日期字符串
+func (h HijriDate) ToDateString() string {
+	return h.Format("j F Y")
+}
+
+// String outputs a string in YYYY-MM-DD HH::ii::ss format like "1441-12-15 13:14:15", implement Stringer interface.
+// 输出 YYYY-MM-DD HH::ii::ss 格式字符串，实现 Stringer 接口
+func (h HijriDate) String() string {
+	return h.Format("Y-m-d H:i:s")
+}
+
+// Format outputs a string by the Hijri format like "Y/m/d" or "j F Y", the symbols are escaped by "\\".
+// Y: year like 1441, m: month like 09, n: month like 9, F: month string like "رمضان", d: day like 05, j: day like 5,
+// t: days in the month like 30, L: 1 if it is a leap year otherwise 0, H: hour like 13, i: minute like 14, s: second like 15.
+// 输出指定伊斯兰历格式模板的字符串，使用 "\\" 转义
+func (h HijriDate) Format(format string) string {
+	if h.IsInvalid() {
+		return ""
+	}
+	var buffer strings.Builder
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\\': // raw output, no parse
+			if i+1 < len(format) {
+				buffer.WriteByte(format[i+1])
+				i++
+			}
+		case 'Y':
+			buffer.WriteString(strconv.Itoa(h.year))
+		case 'm':
+			buffer.WriteString(fmt.Sprintf("%02d", h.month))
+		case 'n':
+			buffer.WriteString(strconv.Itoa(h.month))
+		case 'F':
+			buffer.WriteString(h.ToMonthString())
+		case 'd':
+			buffer.WriteString(fmt.Sprintf("%02d", h.day))
+		case 'j':
+			buffer.WriteString(strconv.Itoa(h.day))
+		case 't':
+			buffer.WriteString(strconv.Itoa(h.DaysInMonth()))
+		case 'L':
+			if h.IsLeapYear() {
+				buffer.WriteString("1")
+			} else {
+				buffer.WriteString("0")
+			}
+		case 'H':
+			buffer.WriteString(fmt.Sprintf("%02d", h.hour))
+		case 'i':
+			buffer.WriteString(fmt.Sprintf("%02d", h.minute))
+		case 's':
+			buffer.WriteString(fmt.Sprintf("%02d", h.second))
+		default:
+			buffer.WriteByte(format[i])
+		}
+	}
+	return buffer.String()
+}
+
+// gets the supported Hijri years of the calendar.
+// 获取该伊斯兰历支持的年份范围
+func getHijriYearRange(calendar string) (first, last int) {
+	if calendar == HijriUmmAlQura {
+		return minUmmAlQuraYear, maxUmmAlQuraYear
+	}
+	return minHijriYear, maxHijriYear
+}
+
+// gets the number of days from 1970-01-01 to the Hijri date.
+// 获取从 1970-01-01 到伊斯兰历日期的天数
+func getHijriDays(year, month, day int, calendar string) (int, error) {
+	first, last := getHijriYearRange(calendar)
+	if year < first || year > last || month < 1 || month > MonthsPerYear || day < 1 || day > getDaysInHijriMonth(year, month, calendar) {
+		return 0, invalidHijriDateError(year, month, day, calendar)
+	}
+	if calendar == HijriUmmAlQura {
+		return ummAlQuraMonths[(year-minUmmAlQuraYear)*MonthsPerYear+month-1] + day - 1, nil
+	}
+	return getTabularHijriDays(year, month, day), nil
+}
+
+// gets the days in the Hijri month, the months of the tabular calendar have 30 and 29 days alternately,
+// and Dhu al-Hijjah has 30 days in a leap year.
+// 获取伊斯兰历该月天数，算术伊斯兰历的月份交替为 30 天和 29 天，闰年的 Dhu al-Hijjah 月有 30 天
+func getDaysInHijriMonth(year, month int, calendar string) int {
+	if calendar == HijriUmmAlQura {
+		i := (year-minUmmAlQuraYear)*MonthsPerYear + month - 1
+		return ummAlQuraMonths[i+1] - ummAlQuraMonths[i]
+	}
+	if month == MonthsPerYear && (14+11*year)%30 < 11 {
+		return 30
+	}
+	return 30 - (month+1)%2
+}
+
+// gets the number of days from 1970-01-01 to the date of the tabular calendar,
+// whose leap years are the 2nd, 5th, 7th, 10th, 13th, 16th, 18th, 21st, 24th, 26th and 29th years of a 30-year cycle.
+// 获取从 1970-01-01 到算术伊斯兰历日期的天数，30 年周期中的第 2、5、7、10、13、16、18、21、24、26、29 年为闰年
+func getTabularHijriDays(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + (3+11*year)/30 + hijriEpoch - 1 - unixEpoch
+}
+
+// gets the date of the tabular calendar from the number of days since 1970-01-01.
+// 从距 1970-01-01 的天数获取算术伊斯兰历日期
+func getTabularHijriDate(days int) (year, month, day int) {
+	jdn := days + unixEpoch
+	year, month = (30*(jdn-hijriEpoch)+10646)/10631, MonthsPerYear
+	for month > 1 && getTabularHijriDays(year, month, 1) > days {
+		month--
+	}
+	day = days - getTabularHijriDays(year, month, 1) + 1
+	return
+}
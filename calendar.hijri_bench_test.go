@@ -0,0 +1,37 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_SetHijriCalendar(b *testing.B) {
+	c := NewCarbon()
+	for n := 0; n < b.N; n++ {
+		c.SetHijriCalendar(HijriTabular)
+	}
+}
+
+func BenchmarkCarbon_Hijri(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.Hijri()
+	}
+}
+
+func BenchmarkCarbon_CreateFromHijri(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		CreateFromHijri(1441, 12, 15, 13, 14, 15)
+	}
+}
+
+func BenchmarkCarbon_RamadanStart(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.RamadanStart(1445)
+	}
+}
+
+func BenchmarkHijri_Format(b *testing.B) {
+	h := Now().Hijri()
+	for n := 0; n < b.N; n++ {
+		h.Format("j F Y H:i:s")
+	}
+}
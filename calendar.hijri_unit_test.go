@@ -0,0 +1,196 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarbon_Hijri(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input     string
+		ummAlQura string
+		tabular   string
+	}{
+		0:  {"", "", ""},
+		1:  {"0", "", ""},
+		2:  {"0000-00-00", "", ""},
+		3:  {"00:00:00", "", ""},
+		4:  {"0000-00-00 00:00:00", "", ""},
+		5:  {"2020-08-05 13:14:15", "1441-12-15 13:14:15", "1441-12-15 13:14:15"},
+		6:  {"1990-01-01", "1410-06-04 00:00:00", "1410-06-03 00:00:00"},
+		7:  {"2024-03-11", "1445-09-01 00:00:00", "1445-09-01 00:00:00"},
+		8:  {"2024-04-10", "1445-10-01 00:00:00", "1445-10-01 00:00:00"},
+		9:  {"1937-03-14", "1356-01-01 00:00:00", "1356-01-01 00:00:00"},
+		10: {"2077-11-16", "1500-12-30 00:00:00", "1500-12-29 00:00:00"},
+		11: {"0622-07-19", "", "1-01-01 00:00:00"},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.ummAlQura, Parse(test.input, Riyadh).Hijri().String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.tabular, SetHijriCalendar(HijriTabular).Parse(test.input, Riyadh).Hijri().String(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_CreateFromHijri(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		year, month, day int
+		ummAlQura        string
+		tabular          string
+	}{
+		0: {1441, 12, 15, "2020-08-05", "2020-08-05"},
+		1: {1445, 9, 1, "2024-03-11", "2024-03-11"},
+		2: {1356, 1, 1, "1937-03-14", "1937-03-14"},
+		3: {1500, 12, 30, "2077-11-16", ""},
+		4: {1, 1, 1, "", "0622-07-19"},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.ummAlQura, CreateFromHijri(test.year, test.month, test.day, 0, 0, 0, Riyadh).ToDateString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.tabular, SetHijriCalendar(HijriTabular).CreateFromHijri(test.year, test.month, test.day, 0, 0, 0, Riyadh).ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	// the conversion is reversible for every day
+	for _, calendar := range []string{HijriUmmAlQura, HijriTabular} {
+		for c := SetHijriCalendar(calendar).Parse("1937-03-14 12:00:00", UTC); c.Year() < 2077; c = c.AddDay() {
+			year, month, day := c.Hijri().Date()
+			assert.True(c.CreateFromHijri(year, month, day, 12, 0, 0).Eq(c), calendar+" "+c.ToDateString())
+		}
+	}
+}
+
+func TestHijri_Getter(t *testing.T) {
+	assert := assert.New(t)
+
+	h := Parse("2020-08-05 13:14:15", Riyadh).Hijri()
+	year, month, day, hour, minute, second := h.DateTime()
+	assert.Equal([]int{1441, 12, 15, 13, 14, 15}, []int{year, month, day, hour, minute, second})
+	year, month, day = h.Date()
+	assert.Equal([]int{1441, 12, 15}, []int{year, month, day})
+	hour, minute, second = h.Time()
+	assert.Equal([]int{13, 14, 15}, []int{hour, minute, second})
+	assert.Equal(1441, h.Year())
+	assert.Equal(12, h.Month())
+	assert.Equal(15, h.Day())
+	assert.Equal(29, h.DaysInMonth())
+	assert.Equal("2020-08-05 13:14:15 +0300 +03", h.ToCarbon().ToString())
+
+	h = HijriDate{}
+	year, month, day, hour, minute, second = h.DateTime()
+	assert.Equal([]int{0, 0, 0, 0, 0, 0}, []int{year, month, day, hour, minute, second})
+	year, month, day = h.Date()
+	assert.Equal([]int{0, 0, 0}, []int{year, month, day})
+	hour, minute, second = h.Time()
+	assert.Equal([]int{0, 0, 0}, []int{hour, minute, second})
+	assert.Equal(0, h.Year())
+	assert.Equal(0, h.Month())
+	assert.Equal(0, h.Day())
+	assert.Equal(0, h.DaysInMonth())
+	assert.False(h.IsLeapYear())
+	assert.False(h.IsRamadan())
+	assert.False(h.IsEidAlFitr())
+	assert.False(h.IsEidAlAdha())
+	assert.True(h.IsInvalid())
+	assert.True(h.ToCarbon().IsInvalid())
+}
+
+func TestHijri_IsLeapYear(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		year      int
+		ummAlQura bool
+		tabular   bool
+	}{
+		0: {1441, true, false},
+		1: {1442, false, true},
+		2: {1443, true, false},
+		3: {1445, false, true},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.ummAlQura, CreateFromHijri(test.year, 1, 1, 0, 0, 0, Riyadh).Hijri().IsLeapYear(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.tabular, SetHijriCalendar(HijriTabular).CreateFromHijri(test.year, 1, 1, 0, 0, 0, Riyadh).Hijri().IsLeapYear(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_Ramadan(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		year                                 int
+		ramadanStart, ramadanEnd, fitr, adha string
+	}{
+		0: {1441, "2020-04-24", "2020-05-23", "2020-05-24", "2020-07-31"},
+		1: {1445, "2024-03-11", "2024-04-09", "2024-04-10", "2024-06-16"},
+		2: {1600, "", "", "", ""},
+	}
+
+	for index, test := range tests {
+		c := NewCarbon().SetTimezone(Riyadh)
+		assert.Equal(test.ramadanStart, c.RamadanStart(test.year).ToDateString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.ramadanEnd, c.RamadanEnd(test.year).ToDateString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.fitr, c.EidAlFitr(test.year).ToDateString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.adha, c.EidAlAdha(test.year).ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.True(Parse("2024-03-11", Riyadh).Hijri().IsRamadan())
+	assert.True(Parse("2024-04-09", Riyadh).Hijri().IsRamadan())
+	assert.False(Parse("2024-04-10", Riyadh).Hijri().IsRamadan())
+	assert.True(Parse("2024-04-10", Riyadh).Hijri().IsEidAlFitr())
+	assert.False(Parse("2024-04-11", Riyadh).Hijri().IsEidAlFitr())
+	assert.True(Parse("2024-06-16", Riyadh).Hijri().IsEidAlAdha())
+	assert.False(Parse("2024-06-17", Riyadh).Hijri().IsEidAlAdha())
+}
+
+func TestHijri_Format(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale   string
+		format   string
+		expected string
+	}{
+		0: {"en", "Y/m/d", "1441/12/15"},
+		1: {"en", "Y/n/j H:i:s", "1441/12/15 13:14:15"},
+		2: {"en", "j F Y", "15 Dhu al-Hijjah 1441"},
+		3: {"ar", "j F Y", "15 ذو الحجة 1441"},
+		4: {"ms-MY", "j F Y", "15 Zulhijah 1441"},
+		5: {"id", "j F Y", "15 Zulhijah 1441"},
+		6: {"de", "F", "ذو الحجة"},
+		7: {"en", "t L", "29 1"},
+		8: {"en", "\\Y Y\\", "Y 1441"},
+	}
+
+	for index, test := range tests {
+		h := SetLocale(test.locale).Parse("2020-08-05 13:14:15", Riyadh).Hijri()
+		assert.Equal(test.expected, h.Format(test.format), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.Equal("", HijriDate{}.Format("Y/m/d"))
+	assert.Equal("1 Ramadan 1445", Parse("2024-03-11", Riyadh).Hijri().ToDateString())
+	assert.Equal("1 Ramadan 1445", SetLocale("ms-MY").Parse("2024-03-11", Riyadh).Hijri().ToDateString())
+}
+
+func TestError_Hijri(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(SetHijriCalendar("xxx").Error)
+	assert.NotNil(Parse("xxx").Hijri().Error)
+	assert.NotNil(Parse("1937-03-13").Hijri().Error)
+	assert.NotNil(Parse("2077-11-17").Hijri().Error)
+	assert.NotNil(SetHijriCalendar(HijriTabular).Parse("0622-07-18").Hijri().Error)
+	assert.NotNil(CreateFromHijri(1441, 12, 15, 0, 0, 0, "xxx").Error)
+	assert.NotNil(CreateFromHijri(1355, 12, 1, 0, 0, 0).Error)
+	assert.NotNil(CreateFromHijri(1501, 1, 1, 0, 0, 0).Error)
+	assert.NotNil(CreateFromHijri(1441, 13, 1, 0, 0, 0).Error)
+	assert.NotNil(CreateFromHijri(1441, 12, 30, 0, 0, 0).Error)
+	assert.NotNil(SetHijriCalendar(HijriTabular).CreateFromHijri(0, 1, 1, 0, 0, 0).Error)
+	assert.NotNil(SetHijriCalendar(HijriTabular).CreateFromHijri(1441, 12, 30, 0, 0, 0).Error)
+	assert.NotNil(SetHijriCalendar("xxx").RamadanStart(1445).Error)
+}
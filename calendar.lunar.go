@@ -2,16 +2,31 @@ package carbon
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"time"
 )
 
 var (
-	minYear, maxYear = 1900, 2100
-	lunarNumbers     = []string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
-	lunarMonths      = []string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "腊月"}
-	lunarTimes       = []string{"子时", "丑时", "寅时", "卯时", "辰时", "巳时", "午时", "未时", "申时", "酉时", "戌时", "亥时"}
-	lunarAnimals     = []string{"猴", "鸡", "狗", "猪", "鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊"}
-	lunarFestivals   = []string{"春节", "元宵节", "端午节", "七夕节", "中元节", "中秋节", "重阳节", "寒衣节", "下元节", "腊八节", "小年"}
+	minYear, maxYear = 1600, 2400
+
+	// the Chinese strings which are used if there are no lunar resources like "lunar_months" in the locale
+	// 区域中没有 "lunar_months" 等农历资源时使用的中文字符串
+	lunarNumbers   = []string{"零", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	lunarMonths    = []string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "腊月"}
+	lunarDays      = []string{"初一", "初二", "初三", "初四", "初五", "初六", "初七", "初八", "初九", "初十", "十一", "十二", "十三", "十四", "十五", "十六", "十七", "十八", "十九", "二十", "廿一", "廿二", "廿三", "廿四", "廿五", "廿六", "廿七", "廿八", "廿九", "三十"}
+	lunarDate      = []string{"%s年%s%s"}
+	lunarTimes     = []string{"子时", "丑时", "寅时", "卯时", "辰时", "巳时", "午时", "未时", "申时", "酉时", "戌时", "亥时"}
+	lunarAnimals   = []string{"猴", "鸡", "狗", "猪", "鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊"}
+	lunarCat       = []string{"猫"}
+	lunarLeap      = []string{"闰"}
+	lunarFestivals = []string{"春节", "元宵节", "端午节", "七夕节", "中元节", "中秋节", "重阳节", "寒衣节", "下元节", "腊八节", "小年"}
+
+	// the lunar years from 1900 to 2100, the first day of lunar year 1900 is 1900-01-31
+	// 1900 年至 2100 年的农历年，农历 1900 年第一天为 1900-01-31
+	minTermsYear, maxTermsYear = 1900, 2100
+	lunarOrigin                = time.Date(minTermsYear, 1, 31, 0, 0, 0, 0, time.UTC)
 
 	lunarTerms = []int{
 		0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900-1909
@@ -38,22 +53,124 @@ var (
 	}
 
 	invalidYearError = func(year int) error {
-		return fmt.Errorf("invalid year %d, currently only 200 years from 1900 to 2100 are supported", year)
+		return &LunarYearError{Year: year}
+	}
+
+	invalidLunarCalendarError = func(calendar string) error {
+		return fmt.Errorf("%w %q, please make sure the lunar calendar is %q or %q", ErrInvalidCalendar, calendar, ChineseLunar, VietnameseLunar)
+	}
+
+	invalidLunarOffsetError = func(offset int) error {
+		return fmt.Errorf("%w offset %d, please make sure the lunar offset is between -43200 and 50400 seconds", ErrInvalidCalendar, offset)
+	}
+
+	invalidLunarDateError = func(year, month, day int, isLeapMonth bool) error {
+		if isLeapMonth {
+			return fmt.Errorf("%w %d-%02d-%02d of lunar leap month, please make sure the leap month and day exist", ErrInvalidDate, year, month, day)
+		}
+		return fmt.Errorf("%w %d-%02d-%02d of lunar calendar, please make sure the month and day exist", ErrInvalidDate, year, month, day)
 	}
 )
 
-// lunar defines a lunar struct.
-// 定义 lunar 结构体
-type lunar struct {
-	year, month, day, hour, minute, second int  // 农历年、月、日、时、分、秒
-	isInvalid                              bool // 是否不可利用
-	isLeapMonth                            bool // 是否是闰月
+// lunarCalendar defines a lunarCalendar struct, which is the variant and the reference meridian of the lunar calendar.
+// 定义 lunarCalendar 结构体，即农历的种类和参考子午线
+type lunarCalendar struct {
+	name   string // ChineseLunar or VietnameseLunar, empty means the Chinese calendar in China Standard Time
+	offset int    // seconds east of UTC of the reference meridian
+}
+
+// LunarDate defines a LunarDate struct, which is a lunar date and time with the leap month flag.
+// 定义 LunarDate 结构体，即带有闰月标记的农历日期时间
+type LunarDate struct {
+	year, month, day, hour, minute, second int           // 农历年、月、日、时、分、秒
+	isInvalid                              bool          // 是否不可利用
+	isLeapMonth                            bool          // 是否是闰月
+	calendar                               lunarCalendar // 农历种类和参考子午线
+	carbon                                 Carbon        // 对应的公历时间
 	Error                                  error
 }
 
+// SetLunarCalendar sets the lunar calendar like "chinese" in UTC+8 or "vietnamese" in UTC+7,
+// which decides the month boundaries and the zodiac, the offset is reset to the one of the calendar.
+// 设置农历种类，如 UTC+8 的 "chinese" 或 UTC+7 的 "vietnamese"，决定月份边界和生肖，偏移量重置为该农历的偏移量
+func (c Carbon) SetLunarCalendar(calendar string) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	switch calendar {
+	case ChineseLunar:
+		c.calendar = lunarCalendar{name: ChineseLunar, offset: chinaOffset}
+	case VietnameseLunar:
+		c.calendar = lunarCalendar{name: VietnameseLunar, offset: vietnamOffset}
+	default:
+		c.Error = invalidLunarCalendarError(calendar)
+	}
+	return c
+}
+
+// SetLunarCalendar sets the lunar calendar like "chinese" in UTC+8 or "vietnamese" in UTC+7.
+// 设置农历种类，如 UTC+8 的 "chinese" 或 UTC+7 的 "vietnamese"
+func SetLunarCalendar(calendar string) Carbon {
+	return NewCarbon().SetLunarCalendar(calendar)
+}
+
+// SetLunarOffset sets the offset in seconds east of UTC of the reference meridian, to which the lunar calendar is anchored
+// instead of the location of the instance, so that an instant is always the same lunar date.
+// 设置参考子午线相对 UTC 的偏移秒数，农历以该子午线而不是实例的地区为准，因此同一时刻总是同一农历日期
+func (c Carbon) SetLunarOffset(offset int) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	if offset < -12*SecondsPerHour || offset > 14*SecondsPerHour {
+		c.Error = invalidLunarOffsetError(offset)
+		return c
+	}
+	c.calendar = c.getLunarCalendar()
+	c.calendar.offset = offset
+	return c
+}
+
+// SetLunarOffset sets the offset in seconds east of UTC of the reference meridian like 28800.
+// 设置参考子午线相对 UTC 的偏移秒数，如 28800
+func SetLunarOffset(offset int) Carbon {
+	return NewCarbon().SetLunarOffset(offset)
+}
+
+// gets the lunar calendar, which is the Chinese calendar in China Standard Time by default.
+// 获取农历种类，默认为北京时间的中国农历
+func (c Carbon) getLunarCalendar() lunarCalendar {
+	if c.calendar.name == "" {
+		return lunarCalendar{name: ChineseLunar, offset: chinaOffset}
+	}
+	return c.calendar
+}
+
+// reports whether the calendar is the Chinese calendar in China Standard Time, which is looked up in lunarTerms.
+// 是否是北京时间的中国农历，即可以查 lunarTerms 表
+func (calendar lunarCalendar) isTabular() bool {
+	return calendar.name == ChineseLunar && calendar.offset == chinaOffset
+}
+
+// gets the fixed location of the reference meridian.
+// 获取参考子午线的固定时区
+func (calendar lunarCalendar) getLocation() *time.Location {
+	return time.FixedZone("", calendar.offset)
+}
+
+// gets the day at the reference meridian of the unix timestamp, which is the number of days since the unix epoch,
+// the Chinese calendar in China Standard Time uses the local mean time of Beijing before 1929.
+// 获取秒级时间戳在参考子午线的日期，即自 unix 纪元以来的天数，北京时间的中国农历在 1929 年之前使用北京地方平时
+func (calendar lunarCalendar) getDay(timestamp int64) int {
+	offset := calendar.offset
+	if calendar.isTabular() && timestamp < chinaStandardTimestamp {
+		offset = beijingOffset
+	}
+	return int(math.Floor(float64(timestamp+int64(offset)) / SecondsPerDay))
+}
+
 // Lunar converts the gregorian calendar to the lunar calendar.
 // 将公历转为农历
-func (c Carbon) Lunar() (l lunar) {
+func (c Carbon) Lunar() (l LunarDate) {
 	l.isInvalid, l.isLeapMonth = false, false
 	if c.IsInvalid() {
 		l.Error = c.Error
@@ -61,23 +178,24 @@ func (c Carbon) Lunar() (l lunar) {
 		return
 	}
 	// leapMonths:闰月总数，daysOfYear:年天数，daysOfMonth:月天数，leapMonth:闰月月份
-	daysInYear, daysInMonth, leapMonth := 365, 30, 0
+	daysInMonth, leapMonth := 30, 0
+	// the lunar date is anchored to the reference meridian instead of the location of the instance,
+	// and the calendar days are counted in UTC, so that the daylight saving time does not shift the date
+	l.calendar = c.getLunarCalendar()
+	t := c.time.In(l.calendar.getLocation())
+	y, m, d := t.Date()
+	offset := int((time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() - lunarOrigin.Unix()) / SecondsPerDay)
 	// 有效范围检验
-	year := c.Year()
-	if year < minYear || year > maxYear {
-		l.Error = invalidYearError(year)
+	l.year = y
+	if l.year >= minYear && l.year <= maxYear+1 && offset < getLunarYear(l.year, l.calendar).newYear {
+		l.year--
+	}
+	if l.year < minYear || l.year > maxYear {
+		l.Error = invalidYearError(l.year)
 		l.isInvalid = true
 		return
 	}
-	offset := int(c.DiffAbsInDays(c.CreateFromDateTime(minYear, 1, 31, 0, 0, 0)))
-	for l.year = minYear; l.year <= maxYear && offset > 0; l.year++ {
-		daysInYear = l.getDaysInYear()
-		offset -= daysInYear
-	}
-	if offset < 0 {
-		offset += daysInYear
-		l.year--
-	}
+	offset -= getLunarYear(l.year, l.calendar).newYear
 	leapMonth = l.LeapMonth()
 	for l.month = 1; l.month <= 12 && offset > 0; l.month++ {
 		if leapMonth > 0 && l.month == (leapMonth+1) && !l.isLeapMonth {
@@ -107,16 +225,197 @@ func (c Carbon) Lunar() (l lunar) {
 		l.month--
 	}
 	l.day = offset + 1
-	l.hour, l.minute, l.second = c.Time()
+	l.hour, l.minute, l.second = t.Clock()
+	l.carbon = c
 	return
 }
 
+// CreateFromLunar creates a Carbon instance from a given lunar date and time, isLeapMonth reports whether the month is a leap month,
+// the gregorian day is picked at the reference meridian of the lunar calendar and the time is the wall clock in the location.
+// 从给定的农历年、月、日、时、分、秒创建 Carbon 实例，isLeapMonth 表示是否是闰月，公历日期以该农历的参考子午线为准，时间为当前时区的时间
+func (c Carbon) CreateFromLunar(year, month, day, hour, minute, second int, isLeapMonth bool, timezone ...string) Carbon {
+	if len(timezone) > 0 {
+		c.loc, c.Error = getLocationByTimezone(timezone[0])
+	}
+	if c.Error != nil {
+		return c
+	}
+	days, err := getLunarDays(year, month, day, isLeapMonth, c.getLunarCalendar())
+	if err != nil {
+		c.Error = err
+		return c
+	}
+	y, m, d := lunarOrigin.AddDate(0, 0, days).Date()
+	c.time = time.Date(y, m, d, hour, minute, second, 0, c.loc)
+	return c
+}
+
+// CreateFromLunar creates a Carbon instance from a given lunar date and time, isLeapMonth reports whether the month is a leap month.
+// 从给定的农历年、月、日、时、分、秒创建 Carbon 实例，isLeapMonth 表示是否是闰月
+func CreateFromLunar(year, month, day, hour, minute, second int, isLeapMonth bool, timezone ...string) Carbon {
+	return NewCarbon().CreateFromLunar(year, month, day, hour, minute, second, isLeapMonth, timezone...)
+}
+
+// AddLunarYears adds some lunar years, the leap month becomes the common month if there is no such leap month in the year,
+// and the day is the last day of the month if the month is shorter.
+// N 个农历年后，如果该年没有对应的闰月则为普通月份，如果该月天数不足则为该月最后一天
+func (c Carbon) AddLunarYears(years int) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	l := c.Lunar()
+	if l.Error != nil {
+		c.Error = l.Error
+		return c
+	}
+	l.year += years
+	if l.year < minYear || l.year > maxYear {
+		c.Error = invalidYearError(l.year)
+		return c
+	}
+	if l.isLeapMonth && l.LeapMonth() != l.month {
+		l.isLeapMonth = false
+	}
+	return c.addLunar(l)
+}
+
+// AddLunarYear adds one lunar year.
+// 1 个农历年后
+func (c Carbon) AddLunarYear() Carbon {
+	return c.AddLunarYears(1)
+}
+
+// SubLunarYears subtracts some lunar years.
+// N 个农历年前
+func (c Carbon) SubLunarYears(years int) Carbon {
+	return c.AddLunarYears(-years)
+}
+
+// SubLunarYear subtracts one lunar year.
+// 1 个农历年前
+func (c Carbon) SubLunarYear() Carbon {
+	return c.SubLunarYears(1)
+}
+
+// AddLunarMonths adds some lunar months, the leap month is counted as a month after the common month with the same number,
+// and the day is the last day of the month if the month is shorter.
+// N 个农历月后，闰月计为同序号普通月份之后的一个月，如果该月天数不足则为该月最后一天
+func (c Carbon) AddLunarMonths(months int) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	l := c.Lunar()
+	if l.Error != nil {
+		c.Error = l.Error
+		return c
+	}
+	for ; months > 0; months-- {
+		switch {
+		case !l.isLeapMonth && l.LeapMonth() == l.month:
+			l.isLeapMonth = true
+		case l.month < MonthsPerYear:
+			l.month, l.isLeapMonth = l.month+1, false
+		default:
+			l.year, l.month, l.isLeapMonth = l.year+1, 1, false
+		}
+		if l.year > maxYear {
+			c.Error = invalidYearError(l.year)
+			return c
+		}
+	}
+	for ; months < 0; months++ {
+		switch {
+		case l.isLeapMonth:
+			l.isLeapMonth = false
+		case l.month > 1:
+			l.month--
+			l.isLeapMonth = l.LeapMonth() == l.month
+		default:
+			l.year, l.month = l.year-1, MonthsPerYear
+			if l.year < minYear {
+				c.Error = invalidYearError(l.year)
+				return c
+			}
+			l.isLeapMonth = l.LeapMonth() == l.month
+		}
+	}
+	return c.addLunar(l)
+}
+
+// AddLunarMonth adds one lunar month.
+// 1 个农历月后
+func (c Carbon) AddLunarMonth() Carbon {
+	return c.AddLunarMonths(1)
+}
+
+// SubLunarMonths subtracts some lunar months.
+// N 个农历月前
+func (c Carbon) SubLunarMonths(months int) Carbon {
+	return c.AddLunarMonths(-months)
+}
+
+// SubLunarMonth subtracts one lunar month.
+// 1 个农历月前
+func (c Carbon) SubLunarMonth() Carbon {
+	return c.SubLunarMonths(1)
+}
+
+// converts the moved lunar date back with the time of the lunar date, the day is clamped to the month.
+// 将移动后的农历日期转换回公历，保留农历的时间，日期不超过该月天数
+func (c Carbon) addLunar(l LunarDate) Carbon {
+	days := l.getDaysInMonth()
+	if l.isLeapMonth {
+		days = l.getDaysInLeapMonth()
+	}
+	if l.day > days {
+		l.day = days
+	}
+	offset, err := getLunarDays(l.year, l.month, l.day, l.isLeapMonth, l.calendar)
+	if err != nil {
+		c.Error = err
+		return c
+	}
+	c.time = time.Date(minTermsYear, 1, 31+offset, l.hour, l.minute, l.second, c.Nanosecond(), l.calendar.getLocation())
+	return c
+}
+
+// gets the number of days from the first day of lunar year 1900 to the given lunar date, which is negative before 1900.
+// 获取从农历 1900 年第一天到给定农历日期的天数
+func getLunarDays(year, month, day int, isLeapMonth bool, calendar lunarCalendar) (int, error) {
+	if year < minYear || year > maxYear {
+		return 0, invalidYearError(year)
+	}
+	l := LunarDate{year: year, month: month, calendar: calendar}
+	if month < 1 || month > MonthsPerYear || (isLeapMonth && l.LeapMonth() != month) {
+		return 0, invalidLunarDateError(year, month, day, isLeapMonth)
+	}
+	days := l.getDaysInMonth()
+	if isLeapMonth {
+		days = l.getDaysInLeapMonth()
+	}
+	if day < 1 || day > days {
+		return 0, invalidLunarDateError(year, month, day, isLeapMonth)
+	}
+
+	offset := getLunarYear(year, calendar).newYear + day - 1
+	for l.month = 1; l.month < month; l.month++ {
+		offset += l.getDaysInMonth()
+		if l.LeapMonth() == l.month {
+			offset += l.getDaysInLeapMonth()
+		}
+	}
+	if isLeapMonth {
+		offset += l.getDaysInMonth()
+	}
+	return offset, nil
+}
+
 // getDaysInYear gets total days in lunar year.
 // 获取该年总天数
-func (l lunar) getDaysInYear() int {
+func (l LunarDate) getDaysInYear() int {
 	var sum = 348
 	for i := 0x8000; i > 0x8; i >>= 1 {
-		if (lunarTerms[l.year-minYear] & i) != 0 {
+		if (getLunarYear(l.year, l.calendar).terms & i) != 0 {
 			sum++
 		}
 	}
@@ -125,8 +424,8 @@ func (l lunar) getDaysInYear() int {
 
 // getDaysInMonth gets total days in lunar month.
 // 获取该月总天数
-func (l lunar) getDaysInMonth() int {
-	if (lunarTerms[l.year-minYear] & (0x10000 >> uint(l.month))) == 0 {
+func (l LunarDate) getDaysInMonth() int {
+	if (getLunarYear(l.year, l.calendar).terms & (0x10000 >> uint(l.month))) == 0 {
 		return 29
 	}
 	return 30
@@ -134,63 +433,68 @@ func (l lunar) getDaysInMonth() int {
 
 // getDaysInLeapMonth gets total days in lunar leap month.
 // 获取闰月总天数
-func (l lunar) getDaysInLeapMonth() int {
+func (l LunarDate) getDaysInLeapMonth() int {
 	if l.LeapMonth() == 0 {
 		return 0
 	}
-	if (lunarTerms[l.year-minYear] & 0x10000) != 0 {
+	if (getLunarYear(l.year, l.calendar).terms & 0x10000) != 0 {
 		return 30
 	}
 	return 29
 }
 
-// Animal gets lunar animal name like "猴".
-// 获取生肖
-func (l lunar) Animal() string {
-	if l.isInvalid {
+// Animal gets lunar animal name like "猴" or "Monkey" in the locale.
+// 获取当前区域的生肖
+func (l LunarDate) Animal() string {
+	if l.IsInvalid() {
 		return ""
 	}
-	return lunarAnimals[l.year%MonthsPerYear]
+	// the year of the rabbit is the year of the cat in the Vietnamese calendar
+	if l.calendar.name == VietnameseLunar && l.year%MonthsPerYear == 7 {
+		return l.getResources("lunar_cat", lunarCat)[0]
+	}
+	return l.getResources("lunar_animals", lunarAnimals)[l.year%MonthsPerYear]
 }
 
-// Festival gets lunar festival name like "春节".
-// 获取农历节日
-func (l lunar) Festival() (festival string) {
-	if l.isInvalid {
+// Festival gets lunar festival name like "春节" or "Spring Festival" in the locale.
+// 获取当前区域的农历节日
+func (l LunarDate) Festival() (festival string) {
+	if l.IsInvalid() {
 		return
 	}
+	festivals := l.getResources("lunar_festivals", lunarFestivals)
 	month, day := l.month, l.day
 	switch {
 	case month == 1 && day == 1:
-		festival = lunarFestivals[0]
+		festival = festivals[0]
 	case month == 1 && day == 15:
-		festival = lunarFestivals[1]
+		festival = festivals[1]
 	case month == 5 && day == 5:
-		festival = lunarFestivals[2]
+		festival = festivals[2]
 	case month == 7 && day == 7:
-		festival = lunarFestivals[3]
+		festival = festivals[3]
 	case month == 7 && day == 15:
-		festival = lunarFestivals[4]
+		festival = festivals[4]
 	case month == 8 && day == 15:
-		festival = lunarFestivals[5]
+		festival = festivals[5]
 	case month == 9 && day == 9:
-		festival = lunarFestivals[6]
+		festival = festivals[6]
 	case month == 10 && day == 1:
-		festival = lunarFestivals[7]
+		festival = festivals[7]
 	case month == 10 && day == 15:
-		festival = lunarFestivals[8]
+		festival = festivals[8]
 	case month == 12 && day == 8:
-		festival = lunarFestivals[9]
+		festival = festivals[9]
 	case month == 12 && day == 23:
-		festival = lunarFestivals[10]
+		festival = festivals[10]
 	}
 	return
 }
 
 // DateTime gets lunar year, month, day, hour, minute, and second like 2020, 8, 5, 13, 14, 15.
 // 获取农历年月日时分秒
-func (l lunar) DateTime() (year, month, day, hour, minute, second int) {
-	if l.isInvalid {
+func (l LunarDate) DateTime() (year, month, day, hour, minute, second int) {
+	if l.IsInvalid() {
 		return
 	}
 	return l.year, l.month, l.day, l.hour, l.minute, l.second
@@ -198,8 +502,8 @@ func (l lunar) DateTime() (year, month, day, hour, minute, second int) {
 
 // Date gets lunar year, month and day like 2020, 8, 5.
 // 获取农历时分秒
-func (l lunar) Date() (year, month, day int) {
-	if l.isInvalid {
+func (l LunarDate) Date() (year, month, day int) {
+	if l.IsInvalid() {
 		return
 	}
 	return l.year, l.month, l.day
@@ -207,8 +511,8 @@ func (l lunar) Date() (year, month, day int) {
 
 // Time gets lunar hour, minute, and second like 13, 14, 15.
 // 获取农历时分秒
-func (l lunar) Time() (hour, minute, second int) {
-	if l.isInvalid {
+func (l LunarDate) Time() (hour, minute, second int) {
+	if l.IsInvalid() {
 		return
 	}
 	return l.hour, l.minute, l.second
@@ -216,8 +520,8 @@ func (l lunar) Time() (hour, minute, second int) {
 
 // Year gets lunar year like 2020.
 // 获取农历年
-func (l lunar) Year() int {
-	if l.isInvalid {
+func (l LunarDate) Year() int {
+	if l.IsInvalid() {
 		return 0
 	}
 	return l.year
@@ -225,8 +529,8 @@ func (l lunar) Year() int {
 
 // Month gets lunar month like 8.
 // 获取农历月
-func (l lunar) Month() int {
-	if l.isInvalid {
+func (l LunarDate) Month() int {
+	if l.IsInvalid() {
 		return 0
 	}
 	return l.month
@@ -234,90 +538,151 @@ func (l lunar) Month() int {
 
 // LeapMonth gets lunar leap month like 8.
 // 获取农历闰月月份
-func (l lunar) LeapMonth() int {
-	if l.isInvalid {
+func (l LunarDate) LeapMonth() int {
+	if l.IsInvalid() {
 		return 0
 	}
-	return lunarTerms[l.year-minYear] & 0xf
+	return getLunarYear(l.year, l.calendar).terms & 0xf
 }
 
 // Day gets lunar day like 5.
 // 获取农历日
-func (l lunar) Day() int {
-	if l.isInvalid {
+func (l LunarDate) Day() int {
+	if l.IsInvalid() {
 		return 0
 	}
 	return l.day
 }
 
-// ToYearString outputs a string in lunar year format like "二零二零".
-// 获取农历年字符串
-func (l lunar) ToYearString() string {
-	if l.isInvalid {
+// ToYearString outputs a string in lunar year format like "二零二零" or "2020" in the locale.
+// 获取当前区域的农历年字符串
+func (l LunarDate) ToYearString() string {
+	if l.IsInvalid() {
 		return ""
 	}
-	year := fmt.Sprintf("%d", l.year)
-	for i, replace := range lunarNumbers {
-		year = strings.Replace(year, fmt.Sprintf("%d", i), replace, -1)
+	numbers := l.getResources("lunar_numbers", lunarNumbers)
+	var year strings.Builder
+	for _, digit := range strconv.Itoa(l.year) {
+		year.WriteString(numbers[digit-'0'])
 	}
-	return year
+	return year.String()
 }
 
-// ToMonthString outputs a string in lunar month format like "正月".
-// 获取农历月字符串
-func (l lunar) ToMonthString() string {
-	if l.isInvalid {
+// ToMonthString outputs a string in lunar month format like "正月" or "First Month" in the locale.
+// 获取当前区域的农历月字符串
+func (l LunarDate) ToMonthString() string {
+	if l.IsInvalid() {
 		return ""
 	}
-	return lunarMonths[l.month-1]
+	return l.getResources("lunar_months", lunarMonths)[l.month-1]
 }
 
-// ToDayString outputs a string in lunar day format like "廿一".
-// 获取农历日字符串
-func (l lunar) ToDayString() (day string) {
-	if l.isInvalid {
-		return
+// ToDayString outputs a string in lunar day format like "廿一" or "21" in the locale.
+// 获取当前区域的农历日字符串
+func (l LunarDate) ToDayString() string {
+	if l.IsInvalid() {
+		return ""
 	}
-	num := lunarNumbers[l.day%10]
-	switch {
-	case l.day == 30:
-		day = "三十"
-	case l.day > 20:
-		day = "廿" + num
-	case l.day == 20:
-		day = "二十"
-	case l.day > 10:
-		day = "十" + num
-	case l.day == 10:
-		day = "初十"
-	case l.day < 10:
-		day = "初" + num
+	return l.getResources("lunar_days", lunarDays)[l.day-1]
+}
+
+// ToDateString outputs a string in lunar date format like "二零二零年腊月初五" or "Twelfth Month 5, 2020" in the locale.
+// 获取当前区域的农历日期字符串
+func (l LunarDate) ToDateString() string {
+	if l.IsInvalid() {
+		return ""
 	}
-	return
+	return fmt.Sprintf(l.getResources("lunar_date", lunarDate)[0], l.ToYearString(), l.ToMonthString(), l.ToDayString())
 }
 
-// ToDateString outputs a string in lunar date format like "二零二零年腊月初五".
-// 获取农历日期字符串
-func (l lunar) ToDateString() string {
-	if l.isInvalid {
+// Format outputs a string by the lunar format like "y年LFD" or "U年 A", the symbols are escaped by "\\".
+// Y: year like 2020, y: year string like "二零二零", m: month like 06, n: month like 6, F: month string like "六月",
+// L: leap month marker like "闰" which is empty if it is not a leap month, d: day like 05, j: day like 5, D: day string like "初五",
+// H: hour like 13, i: minute like 14, s: second like 15, A: animal like "鼠", E: festival like "春节", T: double hour like "未时",
+// U: year pillar like "庚子", V: month pillar like "癸未", W: day pillar like "庚辰", X: hour pillar like "癸未".
+// 输出指定农历格式模板的字符串，使用 "\\" 转义
+func (l LunarDate) Format(format string) string {
+	if l.IsInvalid() {
 		return ""
 	}
-	return l.ToYearString() + "年" + l.ToMonthString() + l.ToDayString()
+	var buffer strings.Builder
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\\': // raw output, no parse
+			if i+1 < len(format) {
+				buffer.WriteByte(format[i+1])
+				i++
+			}
+		case 'Y':
+			buffer.WriteString(strconv.Itoa(l.year))
+		case 'y':
+			buffer.WriteString(l.ToYearString())
+		case 'm':
+			buffer.WriteString(fmt.Sprintf("%02d", l.month))
+		case 'n':
+			buffer.WriteString(strconv.Itoa(l.month))
+		case 'F':
+			buffer.WriteString(l.ToMonthString())
+		case 'L':
+			if l.isLeapMonth {
+				buffer.WriteString(l.getResources("lunar_leap", lunarLeap)[0])
+			}
+		case 'd':
+			buffer.WriteString(fmt.Sprintf("%02d", l.day))
+		case 'j':
+			buffer.WriteString(strconv.Itoa(l.day))
+		case 'D':
+			buffer.WriteString(l.ToDayString())
+		case 'H':
+			buffer.WriteString(fmt.Sprintf("%02d", l.hour))
+		case 'i':
+			buffer.WriteString(fmt.Sprintf("%02d", l.minute))
+		case 's':
+			buffer.WriteString(fmt.Sprintf("%02d", l.second))
+		case 'A':
+			buffer.WriteString(l.Animal())
+		case 'E':
+			buffer.WriteString(l.Festival())
+		case 'T':
+			buffer.WriteString(l.DoubleHour())
+		case 'U':
+			buffer.WriteString(l.YearGanZhi().String())
+		case 'V':
+			buffer.WriteString(l.MonthGanZhi().String())
+		case 'W':
+			buffer.WriteString(l.DayGanZhi().String())
+		case 'X':
+			buffer.WriteString(l.HourGanZhi().String())
+		default:
+			buffer.WriteByte(format[i])
+		}
+	}
+	return buffer.String()
 }
 
 // String outputs a string in YYYY-MM-DD HH::ii::ss format, implement Stringer interface.
 // 输出 YYYY-MM-DD HH::ii::ss 格式字符串， 实现 Stringer 接口
-func (l lunar) String() string {
-	if l.isInvalid {
+func (l LunarDate) String() string {
+	if l.IsInvalid() {
 		return ""
 	}
 	return fmt.Sprintf("%d-%02d-%02d %02d:%02d:%02d", l.year, l.month, l.day, l.hour, l.minute, l.second)
 }
 
+// outputs a string in YYYY-MM-DD HH::ii::ss format whose leap month is suffixed with "L" like "2020-04L-15 13:14:15",
+// it is used by JSON and database.
+// 输出闰月带有 "L" 后缀的 YYYY-MM-DD HH::ii::ss 格式字符串，用于 JSON 和数据库
+func (l LunarDate) toLeapString() string {
+	if l.IsInvalid() || !l.isLeapMonth {
+		return l.String()
+	}
+	return fmt.Sprintf("%d-%02dL-%02d %02d:%02d:%02d", l.year, l.month, l.day, l.hour, l.minute, l.second)
+}
+
 // IsLeapYear reports whether is leap year.
 // 是否是闰年
-func (l lunar) IsLeapYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsLeapYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	return l.LeapMonth() != 0
@@ -325,17 +690,17 @@ func (l lunar) IsLeapYear() bool {
 
 // IsLeapMonth reports whether is leap month.
 // 是否是闰月
-func (l lunar) IsLeapMonth() bool {
-	if l.isInvalid {
+func (l LunarDate) IsLeapMonth() bool {
+	if l.IsInvalid() {
 		return false
 	}
-	return l.month == l.LeapMonth()
+	return l.isLeapMonth
 }
 
 // IsRatYear reports whether is year of Rat.
 // 是否是鼠年
-func (l lunar) IsRatYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsRatYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 4 {
@@ -346,8 +711,8 @@ func (l lunar) IsRatYear() bool {
 
 // IsOxYear reports whether is year of Ox.
 // 是否是牛年
-func (l lunar) IsOxYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsOxYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 5 {
@@ -358,8 +723,8 @@ func (l lunar) IsOxYear() bool {
 
 // IsTigerYear reports whether is year of Tiger.
 // 是否是虎年
-func (l lunar) IsTigerYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsTigerYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 6 {
@@ -370,8 +735,8 @@ func (l lunar) IsTigerYear() bool {
 
 // IsRabbitYear reports whether is year of Rabbit.
 // 是否是兔年
-func (l lunar) IsRabbitYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsRabbitYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 7 {
@@ -382,8 +747,8 @@ func (l lunar) IsRabbitYear() bool {
 
 // IsDragonYear reports whether is year of Dragon.
 // 是否是龙年
-func (l lunar) IsDragonYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsDragonYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 8 {
@@ -394,8 +759,8 @@ func (l lunar) IsDragonYear() bool {
 
 // IsSnakeYear reports whether is year of Snake.
 // 是否是蛇年
-func (l lunar) IsSnakeYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsSnakeYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 9 {
@@ -406,8 +771,8 @@ func (l lunar) IsSnakeYear() bool {
 
 // IsHorseYear reports whether is year of Horse.
 // 是否是马年
-func (l lunar) IsHorseYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsHorseYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 10 {
@@ -418,8 +783,8 @@ func (l lunar) IsHorseYear() bool {
 
 // IsGoatYear reports whether is year of Goat.
 // 是否是羊年
-func (l lunar) IsGoatYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsGoatYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 11 {
@@ -430,8 +795,8 @@ func (l lunar) IsGoatYear() bool {
 
 // IsMonkeyYear reports whether is year of Monkey.
 // 是否是猴年
-func (l lunar) IsMonkeyYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsMonkeyYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 0 {
@@ -442,8 +807,8 @@ func (l lunar) IsMonkeyYear() bool {
 
 // IsRoosterYear reports whether is year of Rooster.
 // 是否是鸡年
-func (l lunar) IsRoosterYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsRoosterYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 1 {
@@ -454,8 +819,8 @@ func (l lunar) IsRoosterYear() bool {
 
 // IsDogYear reports whether is year of Dog.
 // 是否是狗年
-func (l lunar) IsDogYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsDogYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 2 {
@@ -466,8 +831,8 @@ func (l lunar) IsDogYear() bool {
 
 // IsPigYear reports whether is year of Pig.
 // 是否是猪年
-func (l lunar) IsPigYear() bool {
-	if l.isInvalid {
+func (l LunarDate) IsPigYear() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	if l.year%MonthsPerYear == 3 {
@@ -476,46 +841,47 @@ func (l lunar) IsPigYear() bool {
 	return false
 }
 
-// DoubleHour gets double-hour name like "子时".
-// 获取当前时辰
-func (l lunar) DoubleHour() (dh string) {
-	if l.isInvalid {
+// DoubleHour gets double-hour name like "子时" or "Rat Hour" in the locale.
+// 获取当前区域的时辰
+func (l LunarDate) DoubleHour() (dh string) {
+	if l.IsInvalid() {
 		return ""
 	}
+	times := l.getResources("lunar_times", lunarTimes)
 	hour, minute := l.hour, l.minute
 	switch {
 	case hour >= 23, hour == 0 && minute <= 59:
-		dh = lunarTimes[0] // FirstDoubleHour
+		dh = times[0] // FirstDoubleHour
 	case hour >= 1 && hour < 3:
-		dh = lunarTimes[1] // SecondDoubleHour
+		dh = times[1] // SecondDoubleHour
 	case hour >= 3 && hour < 5:
-		dh = lunarTimes[2] // ThirdDoubleHour
+		dh = times[2] // ThirdDoubleHour
 	case hour >= 5 && hour < 7:
-		dh = lunarTimes[3] // FourthDoubleHour
+		dh = times[3] // FourthDoubleHour
 	case hour >= 7 && hour < 9:
-		dh = lunarTimes[4] // FifthDoubleHour
+		dh = times[4] // FifthDoubleHour
 	case hour >= 9 && hour < 11:
-		dh = lunarTimes[5] // SixthDoubleHour
+		dh = times[5] // SixthDoubleHour
 	case hour >= 11 && hour < 13:
-		dh = lunarTimes[6] // SeventhDoubleHour
+		dh = times[6] // SeventhDoubleHour
 	case hour >= 13 && hour < 15:
-		dh = lunarTimes[7] // EighthDoubleHour
+		dh = times[7] // EighthDoubleHour
 	case hour >= 15 && hour < 17:
-		dh = lunarTimes[8] // NinthDoubleHour
+		dh = times[8] // NinthDoubleHour
 	case hour >= 17 && hour < 19:
-		dh = lunarTimes[9] // TenthDoubleHour
+		dh = times[9] // TenthDoubleHour
 	case hour >= 19 && hour < 21:
-		dh = lunarTimes[10] // EleventhDoubleHour
+		dh = times[10] // EleventhDoubleHour
 	case hour >= 21 && hour < 23:
-		dh = lunarTimes[11] // TwelfthDoubleHour
+		dh = times[11] // TwelfthDoubleHour
 	}
 	return
 }
 
 // IsFirstDoubleHour reports whether is FirstDoubleHour.
 // 是否是子时
-func (l lunar) IsFirstDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsFirstDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour, minute := l.hour, l.minute
@@ -530,8 +896,8 @@ func (l lunar) IsFirstDoubleHour() bool {
 
 // IsSecondDoubleHour reports whether is SecondDoubleHour.
 // 是否是丑时
-func (l lunar) IsSecondDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsSecondDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -543,8 +909,8 @@ func (l lunar) IsSecondDoubleHour() bool {
 
 // IsThirdDoubleHour reports whether is ThirdDoubleHour.
 // 是否是寅时
-func (l lunar) IsThirdDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsThirdDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -556,8 +922,8 @@ func (l lunar) IsThirdDoubleHour() bool {
 
 // IsFourthDoubleHour reports whether is FourthDoubleHour.
 // 是否是卯时
-func (l lunar) IsFourthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsFourthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -569,8 +935,8 @@ func (l lunar) IsFourthDoubleHour() bool {
 
 // IsFifthDoubleHour reports whether is FifthDoubleHour.
 // 是否是辰时
-func (l lunar) IsFifthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsFifthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -582,8 +948,8 @@ func (l lunar) IsFifthDoubleHour() bool {
 
 // IsSixthDoubleHour reports whether is SixthDoubleHour.
 // 是否是巳时
-func (l lunar) IsSixthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsSixthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -595,8 +961,8 @@ func (l lunar) IsSixthDoubleHour() bool {
 
 // IsSeventhDoubleHour reports whether is SeventhDoubleHour.
 // 是否是午时
-func (l lunar) IsSeventhDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsSeventhDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -608,8 +974,8 @@ func (l lunar) IsSeventhDoubleHour() bool {
 
 // IsEighthDoubleHour reports whether is EighthDoubleHour.
 // 是否是未时
-func (l lunar) IsEighthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsEighthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -621,8 +987,8 @@ func (l lunar) IsEighthDoubleHour() bool {
 
 // IsNinthDoubleHour reports whether is NinthDoubleHour.
 // 是否是申时
-func (l lunar) IsNinthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsNinthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -634,8 +1000,8 @@ func (l lunar) IsNinthDoubleHour() bool {
 
 // IsTenthDoubleHour reports whether is TenthDoubleHour.
 // 是否是酉时
-func (l lunar) IsTenthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsTenthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -647,8 +1013,8 @@ func (l lunar) IsTenthDoubleHour() bool {
 
 // IsEleventhDoubleHour reports whether is EleventhDoubleHour.
 // 是否是戌时
-func (l lunar) IsEleventhDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsEleventhDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -660,8 +1026,8 @@ func (l lunar) IsEleventhDoubleHour() bool {
 
 // IsTwelfthDoubleHour reports whether is TwelfthDoubleHour.
 // 是否是亥时
-func (l lunar) IsTwelfthDoubleHour() bool {
-	if l.isInvalid {
+func (l LunarDate) IsTwelfthDoubleHour() bool {
+	if l.IsInvalid() {
 		return false
 	}
 	hour := l.hour
@@ -670,3 +1036,132 @@ func (l lunar) IsTwelfthDoubleHour() bool {
 	}
 	return false
 }
+
+// IsInvalid reports whether the lunar date is invalid, the zero value of LunarDate is invalid.
+// 是否是无效的农历日期，LunarDate 的零值无效
+func (l LunarDate) IsInvalid() bool {
+	return l.isInvalid || l.year == 0
+}
+
+// ToCarbon converts the lunar date back to a Carbon instance in the location it was created in.
+// 将农历日期转换回创建时所在位置的 Carbon 实例
+func (l LunarDate) ToCarbon() Carbon {
+	if l.IsInvalid() {
+		c := NewCarbon()
+		c.Error = l.Error
+		return c
+	}
+	return l.carbon
+}
+
+// Eq reports whether equal.
+// 是否等于
+func (l LunarDate) Eq(t LunarDate) bool {
+	if l.IsInvalid() || t.IsInvalid() {
+		return false
+	}
+	return l.compare(t) == 0
+}
+
+// Ne reports whether not equal.
+// 是否不等于
+func (l LunarDate) Ne(t LunarDate) bool {
+	if l.IsInvalid() || t.IsInvalid() {
+		return false
+	}
+	return l.compare(t) != 0
+}
+
+// Gt reports whether greater than, a leap month is after the common month of the same number.
+// 是否大于，闰月在同序号的普通月份之后
+func (l LunarDate) Gt(t LunarDate) bool {
+	if l.IsInvalid() || t.IsInvalid() {
+		return false
+	}
+	return l.compare(t) > 0
+}
+
+// Lt reports whether less than, a leap month is after the common month of the same number.
+// 是否小于，闰月在同序号的普通月份之后
+func (l LunarDate) Lt(t LunarDate) bool {
+	if l.IsInvalid() || t.IsInvalid() {
+		return false
+	}
+	return l.compare(t) < 0
+}
+
+// Gte reports whether greater than or equal.
+// 是否大于等于
+func (l LunarDate) Gte(t LunarDate) bool {
+	if l.IsInvalid() || t.IsInvalid() {
+		return false
+	}
+	return l.compare(t) >= 0
+}
+
+// Lte reports whether less than or equal.
+// 是否小于等于
+func (l LunarDate) Lte(t LunarDate) bool {
+	if l.IsInvalid() || t.IsInvalid() {
+		return false
+	}
+	return l.compare(t) <= 0
+}
+
+// compares the lunar date and time field by field, it returns -1, 0 or 1.
+// 逐字段比较农历日期时间，返回 -1、0 或 1
+func (l LunarDate) compare(t LunarDate) int {
+	leap := func(isLeapMonth bool) int {
+		if isLeapMonth {
+			return 1
+		}
+		return 0
+	}
+	fields := [][2]int{
+		{l.year, t.year}, {l.month, t.month}, {leap(l.isLeapMonth), leap(t.isLeapMonth)},
+		{l.day, t.day}, {l.hour, t.hour}, {l.minute, t.minute}, {l.second, t.second},
+	}
+	for _, field := range fields {
+		switch {
+		case field[0] < field[1]:
+			return -1
+		case field[0] > field[1]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// gets the gregorian date and time at the reference meridian of the lunar calendar.
+// 获取该农历参考子午线的公历日期时间
+func (l LunarDate) getCarbon() Carbon {
+	return l.carbon.SetLocation(l.calendar.getLocation())
+}
+
+// parses a string in the format of String like "2020-04L-15 13:14:15" as a lunar date of the Chinese calendar in the default timezone.
+// 将 String 格式的字符串如 "2020-04L-15 13:14:15" 解析为默认时区的中国农历日期
+func parseLunarDate(value string) LunarDate {
+	if value == "" || value == "null" {
+		return LunarDate{}
+	}
+	data := strings.Replace(value, "L-", "-", 1)
+	var year, month, day, hour, minute, second int
+	if _, err := fmt.Sscanf(data, "%d-%d-%d %d:%d:%d", &year, &month, &day, &hour, &minute, &second); err != nil {
+		return LunarDate{isInvalid: true, Error: invalidValueError(value)}
+	}
+	// the lunar date and time are at the reference meridian, so that the string is the same in any default timezone
+	c := NewCarbon()
+	loc := c.loc
+	c = c.SetLocation(c.getLunarCalendar().getLocation()).CreateFromLunar(year, month, day, hour, minute, second, data != value)
+	l := c.SetLocation(loc).Lunar()
+	if l.Error == nil && l.toLeapString() != value {
+		return LunarDate{isInvalid: true, Error: invalidValueError(value)}
+	}
+	return l
+}
+
+// gets the lunar resources of the locale like "lunar_months", the Chinese ones are used if there are no such resources.
+// 获取该区域的农历资源，如 "lunar_months"，如果没有该资源则使用中文
+func (l LunarDate) getResources(key string, fallback []string) []string {
+	return l.carbon.lang.getResources(key, fallback)
+}
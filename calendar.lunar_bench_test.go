@@ -8,6 +8,26 @@ func BenchmarkCarbon_Lunar(b *testing.B) {
 	}
 }
 
+func BenchmarkCarbon_CreateFromLunar(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		CreateFromLunar(2020, 4, 15, 13, 14, 15, true)
+	}
+}
+
+func BenchmarkCarbon_AddLunarMonths(b *testing.B) {
+	c := CreateFromLunar(2020, 4, 15, 13, 14, 15, true)
+	for n := 0; n < b.N; n++ {
+		c.AddLunarMonths(3)
+	}
+}
+
+func BenchmarkCarbon_AddLunarYears(b *testing.B) {
+	c := CreateFromLunar(2020, 4, 15, 13, 14, 15, true)
+	for n := 0; n < b.N; n++ {
+		c.AddLunarYears(3)
+	}
+}
+
 func BenchmarkLunar_Animal(b *testing.B) {
 	l := Now().Lunar()
 	for n := 0; n < b.N; n++ {
@@ -102,7 +122,7 @@ func BenchmarkLunar_ToDateString(b *testing.B) {
 func BenchmarkLunar_String(b *testing.B) {
 	l := Now().Lunar()
 	for n := 0; n < b.N; n++ {
-		l.String()
+		_ = l.String()
 	}
 }
 
@@ -294,3 +314,45 @@ func BenchmarkLunar_IsTwelfthDoubleHour(b *testing.B) {
 		l.IsTwelfthDoubleHour()
 	}
 }
+
+func BenchmarkCarbon_SetLunarCalendar(b *testing.B) {
+	c := NewCarbon()
+	for n := 0; n < b.N; n++ {
+		c.SetLunarCalendar(VietnameseLunar)
+	}
+}
+
+func BenchmarkCarbon_SetLunarOffset(b *testing.B) {
+	c := NewCarbon()
+	for n := 0; n < b.N; n++ {
+		c.SetLunarOffset(7 * SecondsPerHour)
+	}
+}
+
+func BenchmarkLunar_Locale(b *testing.B) {
+	l := SetLocale("vi").Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.ToDateString()
+	}
+}
+
+func BenchmarkLunar_ToCarbon(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.ToCarbon()
+	}
+}
+
+func BenchmarkLunar_Lt(b *testing.B) {
+	l1, l2 := Now().Lunar(), Tomorrow().Lunar()
+	for n := 0; n < b.N; n++ {
+		l1.Lt(l2)
+	}
+}
+
+func BenchmarkLunar_Format(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.Format("y年LFD A T")
+	}
+}
@@ -40,11 +40,12 @@ func TestLunar_Animal(t *testing.T) {
 		21: {"2020-08-05", "鼠"},
 		22: {"2021-05-12", "牛"},
 		23: {"2021-08-05", "牛"},
-		24: {"2200-08-05", ""},
+		24: {"2200-08-05", "鼠"},
+		25: {"2500-08-05", ""},
 	}
 
 	for index, test := range tests {
-		c := Parse(test.input, PRC)
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().Animal(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -80,7 +81,7 @@ func TestLunar_Festival(t *testing.T) {
 	}
 
 	for index, test := range tests {
-		c := Parse(test.input, PRC)
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().Festival(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -330,7 +331,7 @@ func TestLunar_ToYearString(t *testing.T) {
 	}
 
 	for index, test := range tests {
-		c := Parse(test.input, PRC)
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().ToYearString(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -369,7 +370,7 @@ func TestLunar_ToMonthString(t *testing.T) {
 	}
 
 	for index, test := range tests {
-		c := Parse(test.input, PRC)
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().ToMonthString(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -407,7 +408,7 @@ func TestLunar_ToDayString(t *testing.T) {
 	}
 
 	for index, test := range tests {
-		c := Parse(test.input, PRC)
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().ToDayString(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -477,7 +478,7 @@ func TestLunar_ToDateString(t *testing.T) {
 	}
 
 	for index, test := range tests {
-		c := Parse(test.input, PRC)
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().ToDateString(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -523,11 +524,14 @@ func TestLunar_IsLeapMonth(t *testing.T) {
 		3: {"00:00:00", false},
 		4: {"0000-00-00 00:00:00", false},
 
-		5: {"2020-04-23", true},
-		6: {"2020-05-01", true},
-		7: {"2020-08-05", false},
-		8: {"2021-01-01", false},
-		9: {"2021-07-07", false},
+		5:  {"2020-04-23", false},
+		6:  {"2020-05-01", false},
+		7:  {"2020-05-23", true},
+		8:  {"2020-06-01", true},
+		9:  {"2020-06-21", false},
+		10: {"2020-08-05", false},
+		11: {"2021-01-01", false},
+		12: {"2021-07-07", false},
 	}
 
 	for index, test := range tests {
@@ -889,7 +893,7 @@ func TestLunar_DoubleHour(t *testing.T) {
 	}
 
 	for index, test := range tests {
-		c := SetTimezone(PRC).Parse(test.input)
+		c := SetLocale("zh-CN").SetTimezone(PRC).Parse(test.input)
 		assert.Nil(c.Error)
 		assert.Equal(test.expected, c.Lunar().DoubleHour(), "Current test index is "+strconv.Itoa(index))
 	}
@@ -1195,7 +1199,339 @@ func TestLunar_IsTwelfthDoubleHour(t *testing.T) {
 	}
 }
 
+func TestCarbon_CreateFromLunar(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		year, month, day, hour, minute, second int
+		isLeapMonth                            bool
+		expected                               string
+	}{
+		0: {1900, 1, 1, 0, 0, 0, false, "1900-01-31 00:00:00"},
+		1: {2020, 4, 1, 0, 0, 0, false, "2020-04-23 00:00:00"},
+		2: {2020, 4, 1, 0, 0, 0, true, "2020-05-23 00:00:00"},
+		3: {2020, 4, 15, 13, 14, 15, true, "2020-06-06 13:14:15"},
+		4: {2020, 5, 1, 0, 0, 0, false, "2020-06-21 00:00:00"},
+		5: {2020, 8, 15, 0, 0, 0, false, "2020-10-01 00:00:00"},
+		6: {2020, 12, 15, 13, 14, 15, false, "2021-01-27 13:14:15"},
+		7: {2023, 2, 1, 0, 0, 0, true, "2023-03-22 00:00:00"},
+		8: {2024, 1, 1, 0, 0, 0, false, "2024-02-10 00:00:00"},
+
+		9:  {1600, 1, 1, 0, 0, 0, false, "1600-02-15 00:00:00"},
+		10: {1800, 4, 1, 0, 0, 0, true, "1800-05-24 00:00:00"},
+		11: {1899, 12, 30, 0, 0, 0, false, "1900-01-30 00:00:00"},
+		12: {2101, 1, 1, 0, 0, 0, false, "2101-01-29 00:00:00"},
+		13: {2400, 1, 1, 0, 0, 0, false, "2400-01-27 00:00:00"},
+	}
+
+	for index, test := range tests {
+		c := CreateFromLunar(test.year, test.month, test.day, test.hour, test.minute, test.second, test.isLeapMonth, PRC)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	// the time is the wall clock in the location whatever the reference meridian is
+	for _, timezone := range []string{PRC, NewYork, Tokyo, UTC} {
+		c := CreateFromLunar(2024, 8, 15, 0, 0, 0, false, timezone)
+		assert.Equal("2024-09-17 00:00:00", c.ToDateTimeString(), timezone)
+	}
+
+	// converts every day back and forth at noon, including the days in daylight saving time
+	start := CreateFromDateTime(1600, 2, 15, 12, 0, 0, PRC)
+	for days := 0; days < 365*800; days += 7 {
+		c := start.AddDays(days)
+		l := c.Lunar()
+		assert.Equal(c.ToDateString(), CreateFromLunar(l.year, l.month, l.day, 12, 0, 0, l.isLeapMonth, PRC).ToDateString())
+	}
+}
+
+func TestCarbon_AddLunarMonths(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		months   int
+		expected string
+	}{
+		0: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), 1, "2020-07-05 13:14:15"},
+		1: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), -1, "2020-05-07 13:14:15"},
+		2: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), 12, "2021-05-26 13:14:15"},
+		3: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), -13, "2019-05-19 13:14:15"},
+		4: {CreateFromLunar(2020, 4, 15, 13, 14, 15, false, PRC), 1, "2020-06-06 13:14:15"},
+		5: {CreateFromLunar(2020, 12, 15, 0, 0, 0, false, PRC), 1, "2021-02-26 00:00:00"},
+		6: {CreateFromLunar(2023, 1, 29, 0, 0, 0, false, PRC), 1, "2023-03-20 00:00:00"},
+		7: {CreateFromLunar(2023, 1, 29, 0, 0, 0, false, PRC), 2, "2023-04-19 00:00:00"},
+		8: {CreateFromLunar(2023, 1, 29, 0, 0, 0, false, PRC), 3, "2023-05-18 00:00:00"},
+		9: {CreateFromLunar(2020, 4, 30, 0, 0, 0, false, PRC), 1, "2020-06-20 00:00:00"},
+	}
+
+	for index, test := range tests {
+		c := test.input.AddLunarMonths(test.months)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	c := CreateFromLunar(2020, 4, 15, 0, 0, 0, true, PRC)
+	assert.Equal(c.AddLunarMonths(1).ToDateString(), c.AddLunarMonth().ToDateString())
+	assert.Equal(c.AddLunarMonths(-1).ToDateString(), c.SubLunarMonth().ToDateString())
+	assert.Equal(c.AddLunarMonths(-5).ToDateString(), c.SubLunarMonths(5).ToDateString())
+}
+
+func TestCarbon_AddLunarYears(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		years    int
+		expected string
+	}{
+		0: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), 1, "2021-05-26 13:14:15"},
+		1: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), 3, "2023-06-02 13:14:15"},
+		2: {CreateFromLunar(2020, 4, 15, 13, 14, 15, true, PRC), -1, "2019-05-19 13:14:15"},
+		3: {CreateFromLunar(2023, 1, 29, 0, 0, 0, false, PRC), 1, "2024-03-09 00:00:00"},
+		4: {CreateFromLunar(2020, 8, 15, 0, 0, 0, false, PRC), 1, "2021-09-21 00:00:00"},
+	}
+
+	for index, test := range tests {
+		c := test.input.AddLunarYears(test.years)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	c := CreateFromLunar(2020, 8, 15, 0, 0, 0, false, PRC)
+	assert.Equal(c.AddLunarYears(1).ToDateString(), c.AddLunarYear().ToDateString())
+	assert.Equal(c.AddLunarYears(-1).ToDateString(), c.SubLunarYear().ToDateString())
+	assert.Equal(c.AddLunarYears(-5).ToDateString(), c.SubLunarYears(5).ToDateString())
+}
+
+func TestCarbon_SetLunarCalendar(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		calendar string
+		year     int
+		expected string
+	}{
+		0: {ChineseLunar, 1985, "1985-02-20"},
+		1: {VietnameseLunar, 1985, "1985-01-21"},
+		2: {ChineseLunar, 2007, "2007-02-18"},
+		3: {VietnameseLunar, 2007, "2007-02-17"},
+		4: {ChineseLunar, 2023, "2023-01-22"},
+		5: {VietnameseLunar, 2023, "2023-01-22"},
+		6: {VietnameseLunar, 1600, "1600-02-15"},
+		7: {VietnameseLunar, 2400, "2400-01-27"},
+	}
+
+	for index, test := range tests {
+		c := SetLunarCalendar(test.calendar).CreateFromLunar(test.year, 1, 1, 12, 0, 0, false, Saigon)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateString(), "Current test index is "+strconv.Itoa(index))
+		l := c.Lunar()
+		assert.Equal(test.year, l.Year(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(1, l.Day(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.Equal("兔", SetLocale("zh-CN").Parse("2023-03-01", PRC).Lunar().Animal())
+	assert.Equal("猫", SetLocale("zh-CN").Parse("2023-03-01", PRC).SetLunarCalendar(VietnameseLunar).Lunar().Animal())
+	assert.Equal("牛", SetLocale("zh-CN").Parse("2021-03-01", PRC).SetLunarCalendar(VietnameseLunar).Lunar().Animal())
+}
+
+func TestCarbon_SetLunarOffset(t *testing.T) {
+	assert := assert.New(t)
+
+	// the same instant is the same lunar date in any location
+	c := SetLocale("zh-CN").Parse("2020-08-05 23:30:00", PRC)
+	assert.Equal("二零二零年六月十六", c.Lunar().ToDateString())
+	assert.Equal("二零二零年六月十六", c.SetTimezone(NewYork).Lunar().ToDateString())
+	assert.Equal("二零二零年六月十六", c.SetTimezone(Tokyo).Lunar().ToDateString())
+	assert.Equal(23, c.SetTimezone(Tokyo).Lunar().hour)
+
+	// the reference meridian moves the boundaries of the day and the month
+	assert.Equal("二零二零年六月十七", c.SetLunarOffset(9*SecondsPerHour).Lunar().ToDateString())
+	assert.Equal(0, c.SetLunarOffset(9*SecondsPerHour).Lunar().hour)
+	assert.Equal("二零二零年六月十六", c.SetLunarOffset(8*SecondsPerHour).Lunar().ToDateString())
+	assert.Equal("二零二零年六月十七", SetLunarOffset(-5*SecondsPerHour).SetLocale("zh-CN").SetTimezone(NewYork).Parse("2020-08-05 10:30:00").Lunar().ToDateString())
+
+	// the reference meridian picks the gregorian day and the time is the wall clock in the location
+	assert.Equal("2020-01-25 00:00:00", CreateFromLunar(2020, 1, 1, 0, 0, 0, false, NewYork).ToDateTimeString())
+	assert.Equal("2020-01-24 00:00:00", SetLunarOffset(-5*SecondsPerHour).CreateFromLunar(2020, 1, 1, 0, 0, 0, false, NewYork).ToDateTimeString())
+	assert.Equal("1985-01-21 00:00:00", SetLunarCalendar(VietnameseLunar).CreateFromLunar(1985, 1, 1, 0, 0, 0, false, PRC).ToDateTimeString())
+	assert.Equal("2020-09-03 23:30:00", c.SetTimezone(NewYork).AddLunarMonth().SetTimezone(PRC).ToDateTimeString())
+}
+
+func TestError_SetLunarCalendar(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(SetLunarCalendar("xxx").Error)
+	assert.NotNil(SetLunarOffset(15 * SecondsPerHour).Error)
+	assert.NotNil(SetLunarOffset(-13 * SecondsPerHour).Error)
+	assert.NotNil(Parse("xxx").SetLunarCalendar(ChineseLunar).Error)
+	assert.NotNil(Parse("xxx").SetLunarOffset(0).Error)
+}
+
+func TestLunar_Locale(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale                              string
+		date, month, day, animal, time, mid string
+	}{
+		0: {"en", "Sixth Month 16, 2020", "Sixth Month", "16", "Rat", "Goat Hour", "Mid-Autumn Festival"},
+		1: {"zh-CN", "二零二零年六月十六", "六月", "十六", "鼠", "未时", "中秋节"},
+		2: {"zh-TW", "二零二零年六月十六", "六月", "十六", "鼠", "未時", "中秋節"},
+		3: {"vi", "16 tháng Sáu năm 2020", "Sáu", "16", "Chuột", "giờ Mùi", "Tết Trung Thu"},
+		4: {"kr", "2020년 6월 16일", "6월", "16일", "쥐", "미시", "추석"},
+		5: {"de", "二零二零年六月十六", "六月", "十六", "鼠", "未时", "中秋节"}, // there are no lunar resources
+	}
+
+	for index, test := range tests {
+		l := SetLocale(test.locale).Parse("2020-08-05 13:14:15", PRC).Lunar()
+		assert.Equal(test.date, l.ToDateString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.month, l.ToMonthString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.day, l.ToDayString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.animal, l.Animal(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.time, l.DoubleHour(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.mid, SetLocale(test.locale).Parse("2020-10-01", PRC).Lunar().Festival(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.Equal("2020", Parse("2020-08-05", PRC).Lunar().ToYearString())
+	assert.Equal("Twelfth Month 8, 2020", Parse("2021-01-20", PRC).Lunar().ToDateString())
+	assert.Equal("Laba Festival", Parse("2021-01-20", PRC).Lunar().Festival())
+	assert.Equal("臘八節", SetLocale("zh-TW").Parse("2021-01-20", PRC).Lunar().Festival())
+	assert.Equal("mùng 5 tháng Sáu năm 2020", SetLocale("vi").Parse("2020-07-25", PRC).Lunar().ToDateString())
+	assert.Equal("Cat", SetLunarCalendar(VietnameseLunar).Parse("2023-03-01", PRC).Lunar().Animal())
+	assert.Equal("Mèo", SetLocale("vi").SetLunarCalendar(VietnameseLunar).Parse("2023-03-01", PRC).Lunar().Animal())
+	assert.Equal("Thỏ", SetLocale("vi").Parse("2023-03-01", PRC).Lunar().Animal())
+
+	lang := NewLanguage()
+	lang.SetResources(map[string]string{
+		"lunar_months": "1月|2月|3月|4月|5月|6月|7月|8月|9月|10月|11月|12月",
+		"lunar_date":   "%s/%s/%s",
+	})
+	assert.Equal("二零二零/6月/十六", SetLanguage(lang).Parse("2020-08-05", PRC).Lunar().ToDateString())
+}
+
+func TestLunar_IsInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(LunarDate{}.IsInvalid())
+	assert.True(Parse("").Lunar().IsInvalid())
+	assert.True(Parse("2500-08-05").Lunar().IsInvalid())
+	assert.False(Parse("2020-08-05").Lunar().IsInvalid())
+	assert.Equal("", LunarDate{}.String())
+	assert.Equal("", LunarDate{}.ToDateString())
+	assert.Equal(0, LunarDate{}.LeapMonth())
+}
+
+func TestLunar_ToCarbon(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		timezone string
+		expected string
+	}{
+		0: {"", PRC, ""},
+		1: {"2020-08-05 13:14:15", PRC, "2020-08-05 13:14:15 +0800 CST"},
+		2: {"2020-06-01 13:14:15", PRC, "2020-06-01 13:14:15 +0800 CST"},
+		3: {"2020-08-05 13:14:15", NewYork, "2020-08-05 13:14:15 -0400 EDT"},
+		4: {"2020-08-05 13:14:15", Tokyo, "2020-08-05 13:14:15 +0900 JST"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input, test.timezone).Lunar().ToCarbon()
+		assert.Equal(test.expected, c.ToString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	l := CreateFromLunar(2020, 4, 10, 13, 14, 15, true, PRC).Lunar()
+	assert.True(l.IsLeapMonth())
+	assert.Equal("2020-06-01 13:14:15", l.ToCarbon().ToDateTimeString())
+	assert.Equal("2020-04-10 13:14:15", l.ToCarbon().Lunar().String())
+	assert.True(l.ToCarbon().Lunar().IsLeapMonth())
+}
+
+func TestLunar_Compare(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input1, input2 string
+		eq, gt, lt     bool
+	}{
+		0: {"", "2020-08-05", false, false, false},
+		1: {"2020-08-05", "", false, false, false},
+		2: {"2020-08-05 13:14:15", "2020-08-05 13:14:15", true, false, false},
+		3: {"2020-08-05 13:14:15", "2020-08-05 13:14:16", false, false, true},
+		4: {"2020-08-06", "2020-08-05", false, true, false},
+		5: {"2020-05-22", "2020-05-23", false, false, true}, // 四月 and 闰四月
+		6: {"2020-06-20", "2020-05-22", false, true, false}, // 闰四月 and 四月
+		7: {"2020-01-24", "2020-01-25", false, false, true}, // 除夕 and 春节
+		8: {"2020-08-05 13:14:15", "2020-08-05 13:14:15.999", true, false, false},
+	}
+
+	for index, test := range tests {
+		l1, l2 := Parse(test.input1, PRC).Lunar(), Parse(test.input2, PRC).Lunar()
+		assert.Equal(test.eq, l1.Eq(l2), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.gt, l1.Gt(l2), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.lt, l1.Lt(l2), "Current test index is "+strconv.Itoa(index))
+		valid := !l1.IsInvalid() && !l2.IsInvalid()
+		assert.Equal(valid && !test.eq, l1.Ne(l2), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(valid && !test.lt, l1.Gte(l2), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(valid && !test.gt, l1.Lte(l2), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
 func TestError_Lunar(t *testing.T) {
 	c := CreateFromDate(1840, 1, 1, "xxx").Lunar()
 	assert.NotNil(t, c.Error, "It should catch an exception in Lunar()")
 }
+
+func TestError_CreateFromLunar(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(CreateFromLunar(2020, 8, 15, 0, 0, 0, false, "xxx").Error)
+	assert.NotNil(CreateFromLunar(1599, 8, 15, 0, 0, 0, false, PRC).Error)
+	assert.NotNil(CreateFromLunar(2020, 13, 1, 0, 0, 0, false, PRC).Error)
+	assert.NotNil(CreateFromLunar(2020, 5, 1, 0, 0, 0, true, PRC).Error)
+	assert.NotNil(CreateFromLunar(2020, 4, 30, 0, 0, 0, true, PRC).Error)
+	assert.NotNil(CreateFromLunar(2023, 1, 30, 0, 0, 0, false, PRC).Error)
+	assert.NotNil(CreateFromLunar(2023, 1, 0, 0, 0, 0, false, PRC).Error)
+
+	assert.NotNil(CreateFromLunar(2400, 12, 1, 0, 0, 0, false, PRC).AddLunarMonths(1).Error)
+	assert.NotNil(CreateFromLunar(1600, 1, 1, 0, 0, 0, false, PRC).SubLunarMonths(1).Error)
+	assert.NotNil(CreateFromLunar(2400, 1, 1, 0, 0, 0, false, PRC).AddLunarYears(1).Error)
+	assert.NotNil(Parse("xxx").AddLunarYears(1).Error)
+	assert.NotNil(Parse("xxx").AddLunarMonths(1).Error)
+}
+
+func TestLunar_Format(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		format   string
+		expected string
+	}{
+		0:  {"", "Y-m-d", ""},
+		1:  {"2020-08-05 13:14:15", "Y-m-d H:i:s", "2020-06-16 13:14:15"},
+		2:  {"2020-08-05 13:14:15", "Y-n-j", "2020-6-16"},
+		3:  {"2020-08-05 13:14:15", "y年LFD", "二零二零年六月十六"},
+		4:  {"2020-06-06 13:14:15", "y年LFD", "二零二零年闰四月十五"},
+		5:  {"2020-06-06 13:14:15", "Y-mL-d", "2020-04闰-15"},
+		6:  {"2020-08-05 13:14:15", "A年 T", "鼠年 未时"},
+		7:  {"2020-08-05 13:14:15", "U年 V月 W日 X时", "庚子年 癸未月 庚辰日 癸未时"},
+		8:  {"2020-10-01 13:14:15", "E", "中秋节"},
+		9:  {"2020-08-05 13:14:15", "E", ""},
+		10: {"2020-08-05 13:14:15", "\\Y\\m\\d Y", "Ymd 2020"},
+		11: {"2020-08-05 13:14:15", "Y\\", "2020"},
+	}
+
+	for index, test := range tests {
+		l := SetLocale("zh-CN").Parse(test.input, PRC).Lunar()
+		assert.Equal(test.expected, l.Format(test.format), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.Equal("Leap Fourth Month 15, 2020", SetLocale("en").Parse("2020-06-06", PRC).Lunar().Format("LF j, Y"))
+	assert.Equal("Sixth Month 16, 2020", SetLocale("en").Parse("2020-08-05", PRC).Lunar().Format("LF j, Y"))
+	assert.Equal("閏四月十五", SetLocale("zh-TW").Parse("2020-06-06", PRC).Lunar().Format("LFD"))
+	assert.Equal("闰四月十五", Parse("2020-06-06", PRC).SetLocale("de").Lunar().Format("LFD"))
+}
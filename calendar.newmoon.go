@@ -0,0 +1,212 @@
+package carbon
+
+import (
+	"math"
+	"sync"
+)
+
+var (
+	// the lunar years keyed by lunarYearKey
+	// 按 lunarYearKey 缓存的农历年
+	lunarYears sync.Map
+
+	// the offsets in seconds of China Standard Time and the local mean time of Beijing at 116°25'E,
+	// which was used by the Chinese calendar before 1929
+	// 北京时间和 1929 年之前中国农历使用的北京地方平时（东经 116°25'）的偏移秒数
+	chinaOffset, beijingOffset = 8 * SecondsPerHour, 7*SecondsPerHour + 45*SecondsPerMinute + 40
+
+	// the offset in seconds of Indochina Time, which is used by the Vietnamese calendar
+	// 越南农历使用的印度支那时间的偏移秒数
+	vietnamOffset = 7 * SecondsPerHour
+
+	// the unix timestamp of 1929-01-01 00:00:00 in China Standard Time
+	// 北京时间 1929-01-01 00:00:00 的秒级时间戳
+	chinaStandardTimestamp int64 = -1293868800
+
+	// coefficients of the periodic terms of the new moon, which are multiplied by sin(M'), sin(M), sin(2M') and so on
+	// in the order of Jean Meeus, the exponent of the eccentricity E is the last element
+	// 朔的周期项系数，依次为 sin(M')、sin(M)、sin(2M') 等，最后一个元素为地球轨道离心率 E 的指数
+	newMoonTerms = [][5]float64{
+		// coefficient, multiples of M, M' and F, exponent of E
+		{-0.40720, 0, 1, 0, 0}, {0.17241, 1, 0, 0, 1}, {0.01608, 0, 2, 0, 0}, {0.01039, 0, 0, 2, 0},
+		{0.00739, -1, 1, 0, 1}, {-0.00514, 1, 1, 0, 1}, {0.00208, 2, 0, 0, 2}, {-0.00111, 0, 1, -2, 0},
+		{-0.00057, 0, 1, 2, 0}, {0.00056, 1, 2, 0, 1}, {-0.00042, 0, 3, 0, 0}, {0.00042, 1, 0, 2, 1},
+		{0.00038, 1, 0, -2, 1}, {-0.00024, -1, 2, 0, 1}, {-0.00007, 2, 1, 0, 0}, {0.00004, 0, 2, -2, 0},
+		{0.00004, 3, 0, 0, 0}, {0.00003, 1, 1, -2, 0}, {0.00003, 0, 2, 2, 0}, {-0.00003, 1, 1, 2, 0},
+		{0.00003, -1, 1, 2, 0}, {-0.00002, -1, 1, -2, 0}, {-0.00002, 1, 3, 0, 0}, {0.00002, 0, 4, 0, 0},
+	}
+
+	// planetary arguments of the new moon, each term is the amplitude, phase and frequency per lunation
+	// 朔的行星摄动项，依次为振幅、相位和每朔望月的频率
+	newMoonPlanetaryTerms = [][3]float64{
+		{0.000325, 299.77, 0.107408}, {0.000165, 251.88, 0.016321}, {0.000164, 251.83, 26.651886},
+		{0.000126, 349.42, 36.412478}, {0.000110, 84.66, 18.206239}, {0.000062, 141.74, 53.303771},
+		{0.000060, 207.14, 2.453732}, {0.000056, 154.84, 7.306860}, {0.000047, 34.52, 27.261239},
+		{0.000042, 207.19, 0.121824}, {0.000040, 291.34, 1.844379}, {0.000037, 161.72, 24.198154},
+		{0.000035, 239.56, 25.513099}, {0.000023, 331.55, 3.592518},
+	}
+)
+
+// lunarYear defines a lunarYear struct, which is a lunar year encoded like lunarTerms.
+// 定义 lunarYear 结构体，即与 lunarTerms 编码相同的农历年
+type lunarYear struct {
+	terms   int // month sizes and leap month encoded like lunarTerms
+	newYear int // days from 1900-01-31 to the first day of the year
+}
+
+// lunarYearKey defines a lunarYearKey struct, which is the key of the cached lunar years.
+// 定义 lunarYearKey 结构体，即缓存的农历年的键
+type lunarYearKey struct {
+	calendar lunarCalendar
+	year     int
+}
+
+// gets the lunar year of the calendar, the Chinese calendar in China Standard Time is looked up in lunarTerms from 1900 to 2100,
+// and the other years and calendars are computed astronomically.
+// 获取该农历的农历年，北京时间的中国农历 1900 年至 2100 年查表，其他年份和农历通过天文计算
+func getLunarYear(year int, calendar lunarCalendar) lunarYear {
+	key := lunarYearKey{calendar: calendar, year: year}
+	if y, ok := lunarYears.Load(key); ok {
+		return y.(lunarYear)
+	}
+	var y lunarYear
+	if calendar.isTabular() && year >= minTermsYear && year <= maxTermsYear {
+		y.terms = lunarTerms[year-minTermsYear]
+		for l := (LunarDate{year: minTermsYear, calendar: calendar}); l.year < year; l.year++ {
+			y.newYear += l.getDaysInYear()
+		}
+	} else {
+		y = computeLunarYear(year, calendar)
+	}
+	lunarYears.Store(key, y)
+	return y
+}
+
+// computes the lunar year astronomically, a month begins on the day of the new moon at the reference meridian,
+// the month with the winter solstice is the eleventh month, and if there are 13 months from an eleventh month to the next one,
+// the first month without a major solar term is the leap month.
+// 通过天文计算农历年，以参考子午线的朔日为月首，冬至所在月为十一月，从十一月到下一个十一月有 13 个月时，第一个没有中气的月份为闰月
+func computeLunarYear(year int, calendar lunarCalendar) (y lunarYear) {
+	// the major solar terms are the odd ones from the great cold to the winter solstice
+	var majors []int
+	for i := year - 1; i <= year+1; i++ {
+		for j, instant := range getSolarTermInstants(i) {
+			if j%2 == 1 {
+				majors = append(majors, calendar.getDay(instant))
+			}
+		}
+	}
+	// the new moons which begin the eleventh months from the previous year to the next year
+	first, last := calendar.getNewMoonIndex(majors[11]), calendar.getNewMoonIndex(majors[35])
+	moons := make([]int, 0, last-first+1)
+	for k := first; k <= last; k++ {
+		moons = append(moons, calendar.getDay(getNewMoonTimestamp(k)))
+	}
+	middle := int(calendar.getNewMoonIndex(majors[23]) - first)
+
+	// the first month without a major solar term is the leap month if there are 13 months in a winter solstice year
+	leaps := make(map[int]bool)
+	for _, bounds := range [][2]int{{0, middle}, {middle, len(moons) - 1}} {
+		if bounds[1]-bounds[0] != 13 {
+			continue
+		}
+		for i := bounds[0]; i < bounds[1]; i++ {
+			if !hasMajorSolarTerm(moons[i], moons[i+1], majors) {
+				leaps[i] = true
+				break
+			}
+		}
+	}
+
+	origin := int(lunarOrigin.Unix() / SecondsPerDay)
+	month, inYear := 11, false
+	for i := 0; i < len(moons)-1; i++ {
+		isLeapMonth := leaps[i]
+		if i > 0 && !isLeapMonth {
+			month = month%MonthsPerYear + 1
+		}
+		if month == 1 && !isLeapMonth {
+			if inYear {
+				break
+			}
+			inYear = true
+			y.newYear = moons[i] - origin
+		}
+		if !inYear {
+			continue
+		}
+		days := moons[i+1] - moons[i]
+		switch {
+		case isLeapMonth:
+			y.terms |= month
+			if days == 30 {
+				y.terms |= 0x10000
+			}
+		case days == 30:
+			y.terms |= 0x10000 >> uint(month)
+		}
+	}
+	return
+}
+
+// reports whether there is a major solar term from the start day to the day before the end day.
+// 从开始日到结束日前一天是否有中气
+func hasMajorSolarTerm(start, end int, majors []int) bool {
+	for _, day := range majors {
+		if day >= start && day < end {
+			return true
+		}
+	}
+	return false
+}
+
+// gets the index of the new moon on or before the day at the reference meridian, the index is 0 at the new moon of 2000-01-06.
+// 获取参考子午线该日或之前最近一次朔的序号，2000-01-06 的朔序号为 0
+func (calendar lunarCalendar) getNewMoonIndex(day int) int64 {
+	// 2451550.09766 is the mean new moon of 2000-01-06 and 2440587.5 is the julian day of the unix epoch
+	k := int64(math.Floor((float64(day) + 2440587.5 - 2451550.09766) / 29.530588861))
+	for calendar.getDay(getNewMoonTimestamp(k)) > day {
+		k--
+	}
+	for calendar.getDay(getNewMoonTimestamp(k+1)) <= day {
+		k++
+	}
+	return k
+}
+
+// gets the unix timestamp of the new moon.
+// 获取朔的秒级时间戳
+func getNewMoonTimestamp(k int64) int64 {
+	jde := getNewMoonJDE(float64(k))
+	// converts the dynamical time to the universal time
+	jd := jde - getDeltaT(jde)/SecondsPerDay
+	return int64(math.Round((jd - 2440587.5) * SecondsPerDay))
+}
+
+// gets the julian ephemeris day of the true new moon by the algorithm of Jean Meeus, the error is less than a minute.
+// 通过 Jean Meeus 的算法获取真朔的儒略历书日，误差小于一分钟
+func getNewMoonJDE(k float64) float64 {
+	t := k / 1236.85
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*t*t - 0.00000015*t*t*t + 0.00000000073*t*t*t*t
+	// eccentricity of the Earth's orbit
+	e := 1 - 0.002516*t - 0.0000074*t*t
+	// mean anomaly of the Sun and the Moon, argument of latitude of the Moon and longitude of the ascending node
+	m := (2.5534 + 29.1053567*k - 0.0000014*t*t - 0.00000011*t*t*t) * math.Pi / 180
+	mm := (201.5643 + 385.81693528*k + 0.0107582*t*t + 0.00001238*t*t*t - 0.000000058*t*t*t*t) * math.Pi / 180
+	f := (160.7108 + 390.67050284*k - 0.0016118*t*t - 0.00000227*t*t*t + 0.000000011*t*t*t*t) * math.Pi / 180
+	omega := (124.7746 - 1.56375588*k + 0.0020672*t*t + 0.00000215*t*t*t) * math.Pi / 180
+
+	for _, term := range newMoonTerms {
+		jde += term[0] * math.Pow(e, term[4]) * math.Sin(term[1]*m+term[2]*mm+term[3]*f)
+	}
+	jde -= 0.00017 * math.Sin(omega)
+	a1 := 299.77 + 0.107408*k - 0.009173*t*t
+	for i, term := range newMoonPlanetaryTerms {
+		a := term[1] + term[2]*k
+		if i == 0 {
+			a = a1
+		}
+		jde += term[0] * math.Sin(a*math.Pi/180)
+	}
+	return jde
+}
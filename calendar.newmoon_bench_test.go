@@ -0,0 +1,16 @@
+package carbon
+
+import "testing"
+
+func BenchmarkLunar_ComputeLunarYear(b *testing.B) {
+	calendar := NewCarbon().getLunarCalendar()
+	for n := 0; n < b.N; n++ {
+		computeLunarYear(1800, calendar)
+	}
+}
+
+func BenchmarkLunar_GetNewMoonJDE(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		getNewMoonJDE(-283)
+	}
+}
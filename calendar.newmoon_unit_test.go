@@ -0,0 +1,41 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLunar_ComputeLunarYear(t *testing.T) {
+	assert := assert.New(t)
+
+	// the years in which the published calendars differ from the computation, mostly because of a new moon near midnight,
+	// and the leap month of 2033 is the eleventh month by the current rules instead of the seventh month in the table
+	differences := map[int]bool{1906: true, 1954: true, 1956: true, 1978: true, 2033: true, 2057: true, 2060: true}
+	calendar := NewCarbon().getLunarCalendar()
+	for year := minTermsYear; year <= maxTermsYear; year++ {
+		y := computeLunarYear(year, calendar)
+		assert.Equal(getLunarYear(year, calendar).newYear, y.newYear, "Current year is "+strconv.Itoa(year))
+		if !differences[year] {
+			assert.Equal(lunarTerms[year-minTermsYear], y.terms, "Current year is "+strconv.Itoa(year))
+		}
+	}
+	assert.Equal(11, computeLunarYear(2033, calendar).terms&0xf)
+
+	// the lunar years are continuous through the table and the computation
+	for year := minYear; year < maxYear; year++ {
+		l := LunarDate{year: year, calendar: calendar}
+		assert.Equal(getLunarYear(year+1, calendar).newYear, getLunarYear(year, calendar).newYear+l.getDaysInYear(), "Current year is "+strconv.Itoa(year))
+	}
+}
+
+func TestLunar_GetNewMoonJDE(t *testing.T) {
+	assert := assert.New(t)
+
+	// the example 49.a of Astronomical Algorithms by Jean Meeus, the new moon of 1977-02-18
+	assert.InDelta(2443192.65118, getNewMoonJDE(-283), 0.00001)
+	calendar := NewCarbon().getLunarCalendar()
+	assert.Equal(calendar.getDay(CreateFromDate(1977, 2, 18, PRC).Timestamp()), calendar.getDay(getNewMoonTimestamp(-283)))
+	assert.Equal(int64(-283), calendar.getNewMoonIndex(calendar.getDay(CreateFromDate(1977, 3, 18, PRC).Timestamp())))
+}
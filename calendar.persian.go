@@ -0,0 +1,345 @@
+package carbon
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// the years in which the leap cycle of the Jalali calendar breaks, the conversion is valid between the first and the last one,
+	// see https://github.com/jalaali/jalaali-js
+	// 波斯历闰年周期中断的年份，在第一个和最后一个年份之间转换有效
+	persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+	minPersianYear, maxPersianYear = 1, 3177
+
+	// the Persian strings which are used if there are no Persian resources like "persian_months" in the locale
+	// 区域中没有 "persian_months" 等波斯历资源时使用的波斯语字符串
+	persianMonths  = []string{"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی", "بهمن", "اسفند"}
+	persianNumbers = []string{"۰", "۱", "۲", "۳", "۴", "۵", "۶", "۷", "۸", "۹"}
+
+	// returns an invalid Persian date error.
+	// 无效的波斯历日期错误
+	invalidPersianDateError = func(year, month, day int) error {
+		return fmt.Errorf("%w %d-%02d-%02d of persian calendar, please make sure the date exists and the year is between %d and %d", ErrInvalidDate, year, month, day, minPersianYear, maxPersianYear)
+	}
+)
+
+// PersianDate defines a PersianDate struct, which is a date and time of the Persian (Jalali) calendar.
+// 定义 PersianDate 结构体，即波斯历（贾拉利历）的日期时间
+type PersianDate struct {
+	year, month, day, hour, minute, second int    // 波斯历年、月、日、时、分、秒
+	isInvalid                              bool   // 是否不可利用
+	isPersianDigits                        bool   // 是否使用波斯数字
+	carbon                                 Carbon // 对应的公历时间
+	Error                                  error
+}
+
+// Persian converts the gregorian calendar to the Persian calendar in the location of the instance.
+// 将公历转为当前时区的波斯历
+func (c Carbon) Persian() (p PersianDate) {
+	if c.IsInvalid() {
+		p.Error = c.Error
+		p.isInvalid = true
+		return
+	}
+	t := c.ToStdTime()
+	year, month, day := t.Date()
+	p.year = year - 621
+	if p.year >= minPersianYear && p.year <= maxPersianYear+1 {
+		days := getDaysFromEpoch(year, month, day) - getPersianNewYear(p.year)
+		if days < 0 {
+			p.year--
+			days += 365
+			if isPersianLeapYear(p.year) {
+				days++
+			}
+		}
+		if days < 186 {
+			p.month, p.day = days/31+1, days%31+1
+		} else {
+			days -= 186
+			p.month, p.day = days/30+7, days%30+1
+		}
+	}
+	if p.year < minPersianYear || p.year > maxPersianYear {
+		p.Error = invalidPersianDateError(p.year, p.month, p.day)
+		p.isInvalid = true
+		return
+	}
+	p.hour, p.minute, p.second = t.Clock()
+	p.carbon = c
+	return
+}
+
+// CreateFromPersian creates a Carbon instance from a given Persian date and time.
+// 从给定的波斯历年、月、日、时、分、秒创建 Carbon 实例
+func (c Carbon) CreateFromPersian(year, month, day, hour, minute, second int, timezone ...string) Carbon {
+	if len(timezone) > 0 {
+		c.loc, c.Error = getLocationByTimezone(timezone[0])
+	}
+	if c.Error != nil {
+		return c
+	}
+	if year < minPersianYear || year > maxPersianYear || month < 1 || month > MonthsPerYear ||
+		day < 1 || day > getDaysInPersianMonth(year, month) {
+		c.Error = invalidPersianDateError(year, month, day)
+		return c
+	}
+	days := getPersianNewYear(year) + (month-1)*31 - month/7*(month-7) + day - 1
+	c.time = time.Date(1970, 1, 1+days, hour, minute, second, 0, c.loc)
+	return c
+}
+
+// CreateFromPersian creates a Carbon instance from a given Persian date and time.
+// 从给定的波斯历年、月、日、时、分、秒创建 Carbon 实例
+func CreateFromPersian(year, month, day, hour, minute, second int, timezone ...string) Carbon {
+	return NewCarbon().CreateFromPersian(year, month, day, hour, minute, second, timezone...)
+}
+
+// IsInvalid reports whether the Persian date is invalid, the zero value of PersianDate is invalid.
+// 是否是无效的波斯历日期，PersianDate 的零值无效
+func (p PersianDate) IsInvalid() bool {
+	return p.isInvalid || p.year == 0
+}
+
+// SetPersianDigits sets whether the numbers are output in Persian digits like "۱۳۹۹".
+// 设置是否使用波斯数字输出，如 "۱۳۹۹"
+func (p PersianDate) SetPersianDigits(enabled bool) PersianDate {
+	p.isPersianDigits = enabled
+	return p
+}
+
+// ToCarbon converts the Persian date back to a Carbon instance.
+// 将波斯历日期转换回 Carbon 实例
+func (p PersianDate) ToCarbon() Carbon {
+	if p.IsInvalid() {
+		c := NewCarbon()
+		c.Error = p.Error
+		return c
+	}
+	return p.carbon
+}
+
+// DateTime gets Persian year, month, day, hour, minute, and second like 1399, 5, 15, 13, 14, 15.
+// 获取波斯历年月日时分秒
+func (p PersianDate) DateTime() (year, month, day, hour, minute, second int) {
+	if p.IsInvalid() {
+		return
+	}
+	return p.year, p.month, p.day, p.hour, p.minute, p.second
+}
+
+// Date gets Persian year, month and day like 1399, 5, 15.
+// 获取波斯历年月日
+func (p PersianDate) Date() (year, month, day int) {
+	if p.IsInvalid() {
+		return
+	}
+	return p.year, p.month, p.day
+}
+
+// Time gets Persian hour, minute, and second like 13, 14, 15.
+// 获取波斯历时分秒
+func (p PersianDate) Time() (hour, minute, second int) {
+	if p.IsInvalid() {
+		return
+	}
+	return p.hour, p.minute, p.second
+}
+
+// Year gets Persian year like 1399.
+// 获取波斯历年
+func (p PersianDate) Year() int {
+	if p.IsInvalid() {
+		return 0
+	}
+	return p.year
+}
+
+// Month gets Persian month like 5.
+// 获取波斯历月
+func (p PersianDate) Month() int {
+	if p.IsInvalid() {
+		return 0
+	}
+	return p.month
+}
+
+// Day gets Persian day like 15.
+// 获取波斯历日
+func (p PersianDate) Day() int {
+	if p.IsInvalid() {
+		return 0
+	}
+	return p.day
+}
+
+// DaysInMonth gets total days in Persian month like 31.
+// 获取波斯历该月总天数
+func (p PersianDate) DaysInMonth() int {
+	if p.IsInvalid() {
+		return 0
+	}
+	return getDaysInPersianMonth(p.year, p.month)
+}
+
+// IsLeapYear reports whether is leap year, in which Esfand has 30 days.
+// 是否是闰年，闰年的 Esfand 月有 30 天
+func (p PersianDate) IsLeapYear() bool {
+	if p.IsInvalid() {
+		return false
+	}
+	return isPersianLeapYear(p.year)
+}
+
+// ToMonthString outputs a string in Persian month format like "مرداد" or "Mordad" in the locale.
+// 获取当前区域的波斯历月份字符串
+func (p PersianDate) ToMonthString() string {
+	if p.IsInvalid() {
+		return ""
+	}
+	return p.carbon.lang.getResources("persian_months", persianMonths)[p.month-1]
+}
+
+// ToDateString outputs a string in Persian date format like "15 Mordad 1399" or "۱۵ مرداد ۱۳۹۹" in the locale.
+// 获取当前区域的波斯历日期字符串
+func (p PersianDate) ToDateString() string {
+	return p.Format("j F Y")
+}
+
+// String outputs a string in YYYY-MM-DD HH::ii::ss format like "1399-05-15 13:14:15", implement Stringer interface.
+// 输出 YYYY-MM-DD HH::ii::ss 格式字符串，实现 Stringer 接口
+func (p PersianDate) String() string {
+	return p.Format("Y-m-d H:i:s")
+}
+
+// Format outputs a string by the Persian format like "Y/m/d" or "j F Y", the symbols are escaped by "\\".
+// Y: year like 1399, m: month like 05, n: month like 5, F: month string like "مرداد", d: day like 05, j: day like 5,
+// t: days in the month like 31, L: 1 if it is a leap year otherwise 0, H: hour like 13, i: minute like 14, s: second like 15.
+// 输出指定波斯历格式模板的字符串，使用 "\\" 转义
+func (p PersianDate) Format(format string) string {
+	if p.IsInvalid() {
+		return ""
+	}
+	var buffer strings.Builder
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\\': // raw output, no parse
+			if i+1 < len(format) {
+				buffer.WriteByte(format[i+1])
+				i++
+			}
+		case 'Y':
+			buffer.WriteString(p.toDigits(strconv.Itoa(p.year)))
+		case 'm':
+			buffer.WriteString(p.toDigits(fmt.Sprintf("%02d", p.month)))
+		case 'n':
+			buffer.WriteString(p.toDigits(strconv.Itoa(p.month)))
+		case 'F':
+			buffer.WriteString(p.ToMonthString())
+		case 'd':
+			buffer.WriteString(p.toDigits(fmt.Sprintf("%02d", p.day)))
+		case 'j':
+			buffer.WriteString(p.toDigits(strconv.Itoa(p.day)))
+		case 't':
+			buffer.WriteString(p.toDigits(strconv.Itoa(p.DaysInMonth())))
+		case 'L':
+			if p.IsLeapYear() {
+				buffer.WriteString(p.toDigits("1"))
+			} else {
+				buffer.WriteString(p.toDigits("0"))
+			}
+		case 'H':
+			buffer.WriteString(p.toDigits(fmt.Sprintf("%02d", p.hour)))
+		case 'i':
+			buffer.WriteString(p.toDigits(fmt.Sprintf("%02d", p.minute)))
+		case 's':
+			buffer.WriteString(p.toDigits(fmt.Sprintf("%02d", p.second)))
+		default:
+			buffer.WriteByte(format[i])
+		}
+	}
+	return buffer.String()
+}
+
+// converts the ascii digits to Persian digits if the Persian digits are enabled.
+// 如果启用了波斯数字，将 ascii 数字转换为波斯数字
+func (p PersianDate) toDigits(number string) string {
+	if !p.isPersianDigits {
+		return number
+	}
+	var digits strings.Builder
+	for _, digit := range number {
+		digits.WriteString(persianNumbers[digit-'0'])
+	}
+	return digits.String()
+}
+
+// gets the number of days from 1970-01-01 to the first day of the Persian year, which is the day of the March equinox in Tehran.
+// 获取从 1970-01-01 到波斯历年第一天（德黑兰春分日）的天数
+func getPersianNewYear(year int) int {
+	_, _, march := getPersianCycle(year)
+	return getDaysFromEpoch(year+621, time.March, march)
+}
+
+// reports whether the Persian year is a leap year.
+// 波斯历年份是否是闰年
+func isPersianLeapYear(year int) bool {
+	leap, _, _ := getPersianCycle(year)
+	return leap == 0
+}
+
+// gets the days in the Persian month, the first six months have 31 days, the next five months have 30 days,
+// and Esfand has 29 days or 30 days in a leap year.
+// 获取波斯历该月天数，前六个月 31 天，后五个月 30 天，Esfand 月 29 天，闰年 30 天
+func getDaysInPersianMonth(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	case isPersianLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+// gets the position of the Persian year in the leap cycle which is 0 in a leap year, and the day in March of the first day of the year,
+// by the algorithm of Kazimierz M. Borkowski.
+// 通过 Kazimierz M. Borkowski 的算法获取波斯历年份在闰年周期中的位置（闰年为 0）和该年第一天在三月的日期
+func getPersianCycle(year int) (leap, gregorianYear, march int) {
+	gregorianYear = year + 621
+	leapJ, jp, jump := -14, persianBreaks[0], 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return
+}
+
+// gets the number of days from 1970-01-01 to the gregorian date.
+// 获取从 1970-01-01 到公历日期的天数
+func getDaysFromEpoch(year int, month time.Month, day int) int {
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / SecondsPerDay)
+}
@@ -0,0 +1,30 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_Persian(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.Persian()
+	}
+}
+
+func BenchmarkCarbon_CreateFromPersian(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		CreateFromPersian(1399, 5, 15, 13, 14, 15)
+	}
+}
+
+func BenchmarkPersian_IsLeapYear(b *testing.B) {
+	p := Now().Persian()
+	for n := 0; n < b.N; n++ {
+		p.IsLeapYear()
+	}
+}
+
+func BenchmarkPersian_Format(b *testing.B) {
+	p := Now().Persian().SetPersianDigits(true)
+	for n := 0; n < b.N; n++ {
+		p.Format("j F Y H:i:s")
+	}
+}
@@ -0,0 +1,164 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarbon_Persian(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0:  {"", ""},
+		1:  {"0", ""},
+		2:  {"0000-00-00", ""},
+		3:  {"00:00:00", ""},
+		4:  {"0000-00-00 00:00:00", ""},
+		5:  {"2020-08-05 13:14:15", "1399-05-15 13:14:15"},
+		6:  {"2020-03-19", "1398-12-29 00:00:00"},
+		7:  {"2020-03-20", "1399-01-01 00:00:00"},
+		8:  {"2021-03-20", "1399-12-30 00:00:00"},
+		9:  {"2021-03-21", "1400-01-01 00:00:00"},
+		10: {"2020-09-21", "1399-06-31 00:00:00"},
+		11: {"2020-09-22", "1399-07-01 00:00:00"},
+		12: {"1979-02-11", "1357-11-22 00:00:00"},
+		13: {"2025-03-20", "1403-12-30 00:00:00"},
+		14: {"2000-01-01", "1378-10-11 00:00:00"},
+	}
+
+	for index, test := range tests {
+		p := Parse(test.input, Iran).Persian()
+		assert.Nil(p.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, p.String(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_CreateFromPersian(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		year, month, day, hour, minute, second int
+		expected                               string
+	}{
+		0: {1399, 5, 15, 13, 14, 15, "2020-08-05 13:14:15"},
+		1: {1399, 1, 1, 0, 0, 0, "2020-03-20 00:00:00"},
+		2: {1399, 12, 30, 0, 0, 0, "2021-03-20 00:00:00"},
+		3: {1400, 1, 1, 0, 0, 0, "2021-03-21 00:00:00"},
+		4: {1399, 6, 31, 0, 0, 0, "2020-09-21 00:00:00"},
+		5: {1399, 7, 1, 0, 0, 0, "2020-09-22 00:00:00"},
+		6: {1, 1, 1, 0, 0, 0, "0622-03-22 00:00:00"},
+	}
+
+	for index, test := range tests {
+		c := CreateFromPersian(test.year, test.month, test.day, test.hour, test.minute, test.second, Iran)
+		assert.Nil(c.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	// the conversion is reversible for every day
+	for c := Parse("1800-01-01 12:00:00", UTC); c.Year() < 2200; c = c.AddDay() {
+		year, month, day := c.Persian().Date()
+		assert.True(CreateFromPersian(year, month, day, 12, 0, 0, UTC).Eq(c), c.ToDateString())
+	}
+}
+
+func TestPersian_Getter(t *testing.T) {
+	assert := assert.New(t)
+
+	p := Parse("2020-08-05 13:14:15", Iran).Persian()
+	year, month, day, hour, minute, second := p.DateTime()
+	assert.Equal([]int{1399, 5, 15, 13, 14, 15}, []int{year, month, day, hour, minute, second})
+	year, month, day = p.Date()
+	assert.Equal([]int{1399, 5, 15}, []int{year, month, day})
+	hour, minute, second = p.Time()
+	assert.Equal([]int{13, 14, 15}, []int{hour, minute, second})
+	assert.Equal(1399, p.Year())
+	assert.Equal(5, p.Month())
+	assert.Equal(15, p.Day())
+	assert.Equal(31, p.DaysInMonth())
+	assert.Equal("2020-08-05 13:14:15 +0430 +0430", p.ToCarbon().ToString())
+
+	p = PersianDate{}
+	year, month, day, hour, minute, second = p.DateTime()
+	assert.Equal([]int{0, 0, 0, 0, 0, 0}, []int{year, month, day, hour, minute, second})
+	year, month, day = p.Date()
+	assert.Equal([]int{0, 0, 0}, []int{year, month, day})
+	hour, minute, second = p.Time()
+	assert.Equal([]int{0, 0, 0}, []int{hour, minute, second})
+	assert.Equal(0, p.Year())
+	assert.Equal(0, p.Month())
+	assert.Equal(0, p.Day())
+	assert.Equal(0, p.DaysInMonth())
+	assert.False(p.IsLeapYear())
+	assert.True(p.IsInvalid())
+	assert.True(p.ToCarbon().IsInvalid())
+}
+
+func TestPersian_IsLeapYear(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		year     int
+		expected bool
+	}{
+		0: {1395, true},
+		1: {1396, false},
+		2: {1399, true},
+		3: {1400, false},
+		4: {1403, true},
+		5: {1404, false},
+		6: {1408, true},
+	}
+
+	for index, test := range tests {
+		p := CreateFromPersian(test.year, 1, 1, 0, 0, 0, Iran).Persian()
+		assert.Equal(test.expected, p.IsLeapYear(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, CreateFromPersian(test.year, 12, 30, 0, 0, 0, Iran).Error == nil, "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestPersian_Format(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		format   string
+		digits   bool
+		expected string
+	}{
+		0: {"Y/m/d", false, "1399/05/15"},
+		1: {"Y/n/j H:i:s", false, "1399/5/15 13:14:15"},
+		2: {"Y/m/d", true, "۱۳۹۹/۰۵/۱۵"},
+		3: {"j F Y", false, "15 Mordad 1399"},
+		4: {"t L", false, "31 1"},
+		5: {"\\Y Y\\", false, "Y 1399"},
+	}
+
+	for index, test := range tests {
+		p := Parse("2020-08-05 13:14:15", Iran).Persian().SetPersianDigits(test.digits)
+		assert.Equal(test.expected, p.Format(test.format), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.Equal("", PersianDate{}.Format("Y/m/d"))
+	assert.Equal("15 Mordad 1399", Parse("2020-08-05", Iran).Persian().ToDateString())
+	assert.Equal("۱۵ مرداد ۱۳۹۹", SetLocale("fa").Parse("2020-08-05", Iran).Persian().SetPersianDigits(true).ToDateString())
+	assert.Equal("مرداد", SetLocale("de").Parse("2020-08-05", Iran).Persian().ToMonthString())
+}
+
+func TestError_Persian(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.NotNil(Parse("xxx").Persian().Error)
+	assert.NotNil(Parse("0600-01-01").Persian().Error)
+	assert.NotNil(CreateFromPersian(1399, 5, 15, 0, 0, 0, "xxx").Error)
+	assert.NotNil(CreateFromPersian(0, 1, 1, 0, 0, 0).Error)
+	assert.NotNil(CreateFromPersian(3178, 1, 1, 0, 0, 0).Error)
+	assert.NotNil(CreateFromPersian(1399, 13, 1, 0, 0, 0).Error)
+	assert.NotNil(CreateFromPersian(1399, 7, 31, 0, 0, 0).Error)
+	assert.NotNil(CreateFromPersian(1400, 12, 30, 0, 0, 0).Error)
+	assert.NotNil(CreateFromPersian(1399, 1, 0, 0, 0, 0).Error)
+}
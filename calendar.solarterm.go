@@ -0,0 +1,324 @@
+package carbon
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	solarTerms = []string{"小寒", "大寒", "立春", "雨水", "惊蛰", "春分", "清明", "谷雨", "立夏", "小满", "芒种", "夏至", "小暑", "大暑", "立秋", "处暑", "白露", "秋分", "寒露", "霜降", "立冬", "小雪", "大雪", "冬至"}
+
+	// the instants of the solar terms in unix seconds keyed by gregorian year
+	// 按公历年份缓存的节气时刻秒级时间戳
+	solarTermInstants sync.Map
+
+	// periodic terms of the heliocentric longitude, latitude and radius vector of the Earth, which are the VSOP87 series truncated by Jean Meeus,
+	// each term is the amplitude, phase and frequency of A * cos(B + C * τ)
+	// 地球日心黄经、黄纬和距离的周期项，即 Jean Meeus 截断的 VSOP87 级数
+	earthL = [][][3]float64{
+		{
+			{175347046, 0, 0}, {3341656, 4.6692568, 6283.07585}, {34894, 4.6261, 12566.1517}, {3497, 2.7441, 5753.3849},
+			{3418, 2.8289, 3.5231}, {3136, 3.6277, 77713.7715}, {2676, 4.4181, 7860.4194}, {2343, 6.1352, 3930.2097},
+			{1324, 0.7425, 11506.7698}, {1273, 2.0371, 529.691}, {1199, 1.1096, 1577.3435}, {990, 5.233, 5884.927},
+			{902, 2.045, 26.298}, {857, 3.508, 398.149}, {780, 1.179, 5223.694}, {753, 2.533, 5507.553},
+			{505, 4.583, 18849.228}, {492, 4.205, 775.523}, {357, 2.92, 0.067}, {317, 5.849, 11790.629},
+			{284, 1.899, 796.298}, {271, 0.315, 10977.079}, {243, 0.345, 5486.778}, {206, 4.806, 2544.314},
+			{205, 1.869, 5573.143}, {202, 2.458, 6069.777}, {156, 0.833, 213.299}, {132, 3.411, 2942.463},
+			{126, 1.083, 20.775}, {115, 0.645, 0.98}, {103, 0.636, 4694.003}, {102, 0.976, 15720.839},
+			{102, 4.267, 7.114}, {99, 6.21, 2146.17}, {98, 0.68, 155.42}, {86, 5.98, 161000.69},
+			{85, 1.3, 6275.96}, {85, 3.67, 71430.7}, {80, 1.81, 17260.15}, {79, 3.04, 12036.46},
+			{75, 1.76, 5088.63}, {74, 3.5, 3154.69}, {74, 4.68, 801.82}, {70, 0.83, 9437.76},
+			{62, 3.98, 8827.39}, {61, 1.82, 7084.9}, {57, 2.78, 6286.6}, {56, 4.39, 14143.5},
+			{56, 3.47, 6279.55}, {52, 0.19, 12139.55}, {52, 1.33, 1748.02}, {51, 0.28, 5856.48},
+			{49, 0.49, 1194.45}, {41, 5.37, 8429.24}, {41, 2.4, 19651.05}, {39, 6.17, 10447.39},
+			{37, 6.04, 10213.29}, {37, 2.57, 1059.38}, {36, 1.71, 2352.87}, {36, 1.78, 6812.77},
+			{33, 0.59, 17789.85}, {30, 0.44, 83996.85}, {30, 2.74, 1349.87}, {25, 3.16, 4690.48},
+		},
+		{
+			{628331966747, 0, 0}, {206059, 2.678235, 6283.07585}, {4303, 2.6351, 12566.1517}, {425, 1.59, 3.523},
+			{119, 5.796, 26.298}, {109, 2.966, 1577.344}, {93, 2.59, 18849.23}, {72, 1.14, 529.69},
+			{68, 1.87, 398.15}, {67, 4.41, 5507.55}, {59, 2.89, 5223.69}, {56, 2.17, 155.42},
+			{45, 0.4, 796.3}, {36, 0.47, 775.52}, {29, 2.65, 7.11}, {21, 5.34, 0.98},
+			{19, 1.85, 5486.78}, {19, 4.97, 213.3}, {17, 2.99, 6275.96}, {16, 0.03, 2544.31},
+			{16, 1.43, 2146.17}, {15, 1.21, 10977.08}, {12, 2.83, 1748.02}, {12, 3.26, 5088.63},
+			{12, 5.27, 1194.45}, {12, 2.08, 4694}, {11, 0.77, 553.57}, {10, 1.3, 6286.6},
+			{10, 4.24, 1349.87}, {9, 2.7, 242.73}, {9, 5.64, 951.72}, {8, 5.3, 2352.87},
+			{6, 2.65, 9437.76}, {6, 4.67, 4690.48},
+		},
+		{
+			{52919, 0, 0}, {8720, 1.0721, 6283.0758}, {309, 0.867, 12566.152}, {27, 0.05, 3.52},
+			{16, 5.19, 26.3}, {16, 3.68, 155.42}, {10, 0.76, 18849.23}, {9, 2.06, 77713.77},
+			{7, 0.83, 775.52}, {5, 4.66, 1577.34}, {4, 1.03, 7.11}, {4, 3.44, 5573.14},
+			{3, 5.14, 796.3}, {3, 6.05, 5507.55}, {3, 1.19, 242.73}, {3, 6.12, 529.69},
+			{3, 0.31, 398.15}, {3, 2.28, 553.57}, {2, 4.38, 5223.69}, {2, 3.75, 0.98},
+		},
+		{
+			{289, 5.844, 6283.076}, {35, 0, 0}, {17, 5.49, 12566.15}, {3, 5.2, 155.42},
+			{1, 4.72, 3.52}, {1, 5.3, 18849.23}, {1, 5.97, 242.73},
+		},
+		{
+			{114, 3.142, 0}, {8, 4.13, 6283.08}, {1, 3.84, 12566.15},
+		},
+		{
+			{1, 3.14, 0},
+		},
+	}
+	earthB = [][][3]float64{
+		{
+			{280, 3.199, 84334.662}, {102, 5.422, 5507.553}, {80, 3.88, 5223.69}, {44, 3.7, 2352.87},
+			{32, 4, 1577.34},
+		},
+		{
+			{9, 3.9, 5507.55}, {6, 1.73, 5223.69},
+		},
+	}
+	earthR = [][][3]float64{
+		{
+			{100013989, 0, 0}, {1670700, 3.0984635, 6283.07585}, {13956, 3.05525, 12566.1517}, {3084, 5.1985, 77713.7715},
+			{1628, 1.1739, 5753.3849}, {1576, 2.8469, 7860.4194}, {925, 5.453, 11506.77}, {542, 4.564, 3930.21},
+			{472, 3.661, 5884.927}, {346, 0.964, 5507.553}, {329, 5.9, 5223.694}, {307, 0.299, 5573.143},
+			{243, 4.273, 11790.629}, {212, 5.847, 1577.344}, {186, 5.022, 10977.079}, {175, 3.012, 18849.228},
+			{110, 5.055, 5486.778}, {98, 0.89, 6069.78}, {86, 5.69, 15720.84}, {86, 1.27, 161000.69},
+			{65, 0.27, 17260.15}, {63, 0.92, 529.69}, {57, 2.01, 83996.85}, {56, 5.24, 71430.7},
+			{49, 3.25, 2544.31}, {47, 2.58, 775.52}, {45, 5.54, 9437.76}, {43, 6.01, 6275.96},
+			{39, 5.36, 4694}, {38, 2.39, 8827.39}, {37, 0.83, 19651.05}, {37, 4.9, 12139.55},
+			{36, 1.67, 12036.46}, {35, 1.84, 2942.46}, {33, 0.24, 7084.9}, {32, 0.18, 5088.63},
+			{32, 1.78, 398.15}, {28, 1.21, 6286.6}, {28, 1.9, 6279.55}, {26, 4.59, 10447.39},
+		},
+		{
+			{103019, 1.10749, 6283.07585}, {1721, 1.0644, 12566.1517}, {702, 3.142, 0}, {32, 1.02, 18849.23},
+			{31, 2.84, 5507.55}, {25, 1.32, 5223.69}, {18, 1.42, 1577.34}, {10, 5.91, 10977.08},
+			{9, 1.42, 6275.96}, {9, 0.27, 5486.78},
+		},
+		{
+			{4359, 5.7846, 6283.0758}, {124, 5.579, 12566.152}, {12, 3.14, 0}, {9, 3.63, 77713.77},
+			{6, 1.87, 5573.14}, {3, 5.47, 18849.23},
+		},
+		{
+			{145, 4.273, 6283.076}, {7, 3.92, 12566.15},
+		},
+		{
+			{4, 2.56, 6283.08},
+		},
+	}
+)
+
+// SolarTerm defines a SolarTerm struct, which is one of the 24 solar terms with its exact instant.
+// 定义 SolarTerm 结构体，即二十四节气之一及其交节时刻
+type SolarTerm struct {
+	Index     int    // index in a gregorian year from 0 (小寒) to 23 (冬至)
+	Name      string // localized name like "立春"
+	Longitude int    // apparent solar longitude in degrees like 315
+	Carbon    Carbon // exact instant
+}
+
+// SolarTerm gets the solar term which begins on the day, the name is empty if the day is not a solar term day.
+// 获取当天交节的节气，如果当天不是节气日则名称为空
+func (c Carbon) SolarTerm() (term SolarTerm) {
+	if c.IsInvalid() {
+		return
+	}
+	for _, t := range c.SolarTermsInYear(c.Year()) {
+		if t.Carbon.IsSameDay(c) {
+			return t
+		}
+	}
+	return
+}
+
+// IsSolarTermDay reports whether a solar term begins on the day.
+// 是否是节气日
+func (c Carbon) IsSolarTermDay() bool {
+	return c.SolarTerm().Name != ""
+}
+
+// NextSolarTerm gets the first solar term after the current instance.
+// 获取下一个节气
+func (c Carbon) NextSolarTerm() (term SolarTerm) {
+	if c.IsInvalid() {
+		return
+	}
+	for year := c.Year(); year <= c.Year()+1; year++ {
+		for _, t := range c.SolarTermsInYear(year) {
+			if t.Carbon.Gt(c) {
+				return t
+			}
+		}
+	}
+	return
+}
+
+// PrevSolarTerm gets the last solar term at or before the current instance.
+// 获取上一个节气
+func (c Carbon) PrevSolarTerm() (term SolarTerm) {
+	if c.IsInvalid() {
+		return
+	}
+	for year := c.Year(); year >= c.Year()-1; year-- {
+		terms := c.SolarTermsInYear(year)
+		for i := len(terms) - 1; i >= 0; i-- {
+			if terms[i].Carbon.Lte(c) {
+				return terms[i]
+			}
+		}
+	}
+	return
+}
+
+// SolarTermsInYear gets the 24 solar terms of the gregorian year from 小寒 to 冬至, the names are localized by the language
+// and the instants are in the location of the current instance.
+// 获取公历年份的二十四节气，从小寒到冬至，名称根据当前语言本地化，交节时刻为当前时区
+func (c Carbon) SolarTermsInYear(year int) []SolarTerm {
+	if c.Error != nil {
+		return nil
+	}
+	names := solarTerms
+	if c.lang != nil {
+		if len(c.lang.resources) == 0 {
+			c.lang.SetLocale(defaultLocale)
+		}
+		c.lang.rw.RLock()
+		if slice := strings.Split(c.lang.resources["solar_terms"], "|"); len(slice) == len(solarTerms) {
+			names = slice
+		}
+		c.lang.rw.RUnlock()
+	}
+	instants := getSolarTermInstants(year)
+	terms := make([]SolarTerm, len(instants))
+	for i, instant := range instants {
+		term := c
+		term.time = time.Unix(instant, 0).In(c.loc)
+		terms[i] = SolarTerm{Index: i, Name: names[i], Longitude: (285 + 15*i) % 360, Carbon: term}
+	}
+	return terms
+}
+
+// SolarTermsInYear gets the 24 solar terms of the gregorian year from 小寒 to 冬至.
+// 获取公历年份的二十四节气，从小寒到冬至
+func SolarTermsInYear(year int, timezone ...string) []SolarTerm {
+	c := NewCarbon()
+	if len(timezone) > 0 {
+		c.loc, c.Error = getLocationByTimezone(timezone[0])
+	}
+	return c.SolarTermsInYear(year)
+}
+
+// gets the instants of the 24 solar terms of the gregorian year in unix seconds, the sun reaches the apparent longitude
+// of 285 + 15 * index degrees at each instant.
+// 获取公历年份二十四节气的秒级时间戳，即太阳视黄经到达 285 + 15 * index 度的时刻
+func getSolarTermInstants(year int) []int64 {
+	if instants, ok := solarTermInstants.Load(year); ok {
+		return instants.([]int64)
+	}
+	instants := make([]int64, len(solarTerms))
+	// the minor cold is around January 6, and the sun moves about 15.22 days per term
+	estimate := getJulianDay(time.Date(year, 1, 6, 0, 0, 0, 0, time.UTC))
+	for i := range instants {
+		jde := getSolarLongitudeJDE(float64(285+15*i), estimate+float64(i)*15.2184)
+		// converts the dynamical time to the universal time
+		jd := jde - getDeltaT(jde)/SecondsPerDay
+		instants[i] = int64(math.Round((jd - 2440587.5) * SecondsPerDay))
+	}
+	solarTermInstants.Store(year, instants)
+	return instants
+}
+
+// gets the julian day of the time.
+// 获取儒略日
+func getJulianDay(t time.Time) float64 {
+	return float64(t.Unix())/SecondsPerDay + 2440587.5
+}
+
+// gets the julian ephemeris day when the apparent solar longitude reaches the degrees, starting from the estimated day.
+// 获取太阳视黄经到达指定度数的儒略历书日
+func getSolarLongitudeJDE(degrees, jde float64) float64 {
+	for i := 0; i < 20; i++ {
+		delta := math.Mod(degrees-getSolarLongitude(jde)+540, 360) - 180
+		jde += delta * 365.2422 / 360
+		if math.Abs(delta) < 1e-9 {
+			break
+		}
+	}
+	return jde
+}
+
+// gets the apparent solar longitude in degrees of the julian ephemeris day, which is corrected to the FK5 system,
+// and includes the nutation and aberration.
+// 获取儒略历书日的太阳视黄经，单位为度
+func getSolarLongitude(jde float64) float64 {
+	tau := (jde - 2451545) / 365250
+	series := func(terms [][][3]float64) (sum float64) {
+		for i := len(terms) - 1; i >= 0; i-- {
+			value := 0.0
+			for _, term := range terms[i] {
+				value += term[0] * math.Cos(term[1]+term[2]*tau)
+			}
+			sum = sum*tau + value
+		}
+		return sum / 1e8
+	}
+	// the geocentric longitude is opposite to the heliocentric longitude of the Earth
+	longitude := series(earthL)*180/math.Pi + 180
+	radius := series(earthR)
+	longitude += -0.09033/3600 + getNutationInLongitude(tau*10) - 20.4898/3600/radius
+	return math.Mod(math.Mod(longitude, 360)+360, 360)
+}
+
+// gets the nutation in longitude in degrees of the julian centuries from J2000, the main terms are accurate to 0.5".
+// 获取黄经章动，单位为度
+func getNutationInLongitude(t float64) float64 {
+	omega := (125.04452 - 1934.136261*t) * math.Pi / 180
+	sun := (280.4665 + 36000.7698*t) * math.Pi / 180
+	moon := (218.3165 + 481267.8813*t) * math.Pi / 180
+	return (-17.2*math.Sin(omega) - 1.32*math.Sin(2*sun) - 0.23*math.Sin(2*moon) + 0.21*math.Sin(2*omega)) / 3600
+}
+
+// gets the difference between the dynamical time and the universal time in seconds of the julian ephemeris day,
+// by the polynomial expressions of Espenak and Meeus.
+// 获取力学时与世界时的差值，单位为秒
+func getDeltaT(jde float64) float64 {
+	y := 2000 + (jde-2451545)/365.25
+	switch {
+	case y < 1600:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 1700:
+		t := y - 1600
+		return 120 - 0.9808*t - 0.01532*t*t + t*t*t/7129
+	case y < 1800:
+		t := y - 1700
+		return 8.83 + 0.1603*t - 0.0059285*t*t + 0.00013336*t*t*t - t*t*t*t/1174000
+	case y < 1860:
+		t := y - 1800
+		return 13.72 - 0.332447*t + 0.0068612*t*t + 0.0041116*t*t*t - 0.00037436*math.Pow(t, 4) +
+			0.0000121272*math.Pow(t, 5) - 0.0000001699*math.Pow(t, 6) + 0.000000000875*math.Pow(t, 7)
+	case y < 1900:
+		t := y - 1860
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*math.Pow(t, 4) + math.Pow(t, 5)/233174
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*math.Pow(t, 4)
+	case y < 1941:
+		t := y - 1920
+		return 21.2 + 0.84493*t - 0.0761*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*math.Pow(t, 4) + 0.00002373599*math.Pow(t, 5)
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
@@ -0,0 +1,38 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_SolarTermsInYear(b *testing.B) {
+	c := NewCarbon()
+	for n := 0; n < b.N; n++ {
+		c.SolarTermsInYear(2020)
+	}
+}
+
+func BenchmarkCarbon_SolarTerm(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.SolarTerm()
+	}
+}
+
+func BenchmarkCarbon_IsSolarTermDay(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.IsSolarTermDay()
+	}
+}
+
+func BenchmarkCarbon_NextSolarTerm(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.NextSolarTerm()
+	}
+}
+
+func BenchmarkCarbon_PrevSolarTerm(b *testing.B) {
+	c := Now()
+	for n := 0; n < b.N; n++ {
+		c.PrevSolarTerm()
+	}
+}
@@ -0,0 +1,101 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarbon_SolarTermsInYear(t *testing.T) {
+	assert := assert.New(t)
+
+	// the instants published by the Purple Mountain Observatory in Beijing time
+	tests := []struct {
+		year     int
+		index    int
+		name     string
+		expected string
+	}{
+		0: {2000, 5, "春分", "2000-03-20 15:35"},
+		1: {2020, 0, "小寒", "2020-01-06 05:30"},
+		2: {2020, 2, "立春", "2020-02-04 17:03"},
+		3: {2020, 11, "夏至", "2020-06-21 05:43"},
+		4: {2020, 17, "秋分", "2020-09-22 21:30"},
+		5: {2020, 23, "冬至", "2020-12-21 18:02"},
+		6: {2021, 2, "立春", "2021-02-03 22:58"},
+		7: {2021, 23, "冬至", "2021-12-21 23:59"},
+		8: {2022, 2, "立春", "2022-02-04 04:50"},
+		9: {2024, 5, "春分", "2024-03-20 11:06"},
+	}
+
+	for index, test := range tests {
+		terms := SetLocale("zh-CN").SetTimezone(PRC).SolarTermsInYear(test.year)
+		assert.Len(terms, 24)
+		assert.Equal(test.index, terms[test.index].Index, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.name, terms[test.index].Name, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, terms[test.index].Carbon.Format("Y-m-d H:i"), "Current test index is "+strconv.Itoa(index))
+	}
+
+	// the terms are in order and about 15 days apart, and the equinoxes and solstices are on the expected days
+	for year := 1900; year <= 2100; year++ {
+		terms := SolarTermsInYear(year, UTC)
+		for i := 1; i < len(terms); i++ {
+			days := terms[i].Carbon.DiffInDays(terms[i-1].Carbon)
+			assert.True(days <= -14 && days >= -16, "Current year is "+strconv.Itoa(year))
+		}
+		assert.True(terms[5].Carbon.Month() == 3 && terms[5].Carbon.Day() >= 19 && terms[5].Carbon.Day() <= 21, "Current year is "+strconv.Itoa(year))
+		assert.True(terms[23].Carbon.Month() == 12 && terms[23].Carbon.Day() >= 20 && terms[23].Carbon.Day() <= 23, "Current year is "+strconv.Itoa(year))
+	}
+
+	terms := SolarTermsInYear(2020, UTC)
+	assert.Equal("Spring Equinox", terms[5].Name)
+	assert.Equal(0, terms[5].Longitude)
+	assert.Equal(315, terms[2].Longitude)
+	assert.Equal("2020-03-20 03:49", terms[5].Carbon.Format("Y-m-d H:i"))
+	assert.Equal("입춘", SetLocale("kr").SolarTermsInYear(2020)[2].Name)
+	assert.Equal("立春", SetLocale("de").SolarTermsInYear(2020)[2].Name)
+}
+
+func TestCarbon_SolarTerm(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+		isTerm   bool
+		next     string
+		prev     string
+	}{
+		0: {"", "", false, "", ""},
+		1: {"2020-08-05 13:14:15", "", false, "立秋", "大暑"},
+		2: {"2020-08-07 00:00:00", "立秋", true, "立秋", "大暑"},
+		3: {"2020-08-07 09:06:08", "立秋", true, "处暑", "立秋"},
+		4: {"2020-12-25 00:00:00", "", false, "小寒", "冬至"},
+		5: {"2021-01-01 00:00:00", "", false, "小寒", "冬至"},
+		6: {"2020-02-04 17:03:19", "立春", true, "雨水", "立春"},
+	}
+
+	for index, test := range tests {
+		c := SetLocale("zh-CN").Parse(test.input, PRC)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.SolarTerm().Name, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.isTerm, c.IsSolarTermDay(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.next, c.NextSolarTerm().Name, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.prev, c.PrevSolarTerm().Name, "Current test index is "+strconv.Itoa(index))
+	}
+
+	// the day of a solar term depends on the timezone
+	assert.True(Parse("2021-12-21", PRC).IsSolarTermDay())
+	assert.False(Parse("2021-12-21", "Asia/Tokyo").IsSolarTermDay())
+	assert.True(Parse("2021-12-22", "Asia/Tokyo").IsSolarTermDay())
+}
+
+func TestError_SolarTerm(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(SolarTermsInYear(2020, "xxx"))
+	assert.Equal("", Parse("xxx").SolarTerm().Name)
+	assert.Equal("", Parse("xxx").NextSolarTerm().Name)
+	assert.Equal("", Parse("xxx").PrevSolarTerm().Name)
+}
@@ -57,6 +57,7 @@ const (
 	Seoul      = "Asia/Seoul"          // 首尔
 	Bangkok    = "Asia/Bangkok"        // 曼谷
 	Dubai      = "Asia/Dubai"          // 迪拜
+	Riyadh     = "Asia/Riyadh"         // 利雅得
 	NewYork    = "America/New_York"    // 纽约
 	LosAngeles = "America/Los_Angeles" // 洛杉矶
 	Chicago    = "America/Chicago"     // 芝加哥
@@ -99,6 +100,28 @@ const (
 	Sunday    = "Sunday"    // 周日
 )
 
+// lunar calendar constants
+// 农历常量
+const (
+	ChineseLunar    = "chinese"    // 中国农历
+	VietnameseLunar = "vietnamese" // 越南农历
+)
+
+// lunar anniversary policy constants for the thirtieth day in a month of 29 days
+// 农历周年日在只有 29 天的月份中三十日的处理策略常量
+const (
+	LunarDayClamp = "clamp" // 改为该月最后一天
+	LunarDaySkip  = "skip"  // 跳过该年
+	LunarDayNext  = "next"  // 改为下个月初一
+)
+
+// hijri calendar constants
+// 伊斯兰历常量
+const (
+	HijriUmmAlQura = "ummalqura" // 乌姆库拉历
+	HijriTabular   = "tabular"   // 算术伊斯兰历
+)
+
 // number constants
 // 数字常量
 const (
@@ -135,18 +158,21 @@ const (
 	RubyDateLayout = time.RubyDate
 	UnixDateLayout = time.UnixDate
 
-	RFC1036Layout      = "Mon, 02 Jan 06 15:04:05 -0700"
-	RFC1123Layout      = time.RFC1123
-	RFC1123ZLayout     = time.RFC1123Z
-	RFC2822Layout      = time.RFC1123Z
-	RFC3339Layout      = "2006-01-02T15:04:05Z07:00"
-	RFC3339MilliLayout = "2006-01-02T15:04:05.999Z07:00"
-	RFC3339MicroLayout = "2006-01-02T15:04:05.999999Z07:00"
-	RFC3339NanoLayout  = "2006-01-02T15:04:05.999999999Z07:00"
-	RFC7231Layout      = "Mon, 02 Jan 2006 15:04:05 MST"
-	RFC822Layout       = time.RFC822
-	RFC822ZLayout      = time.RFC822Z
-	RFC850Layout       = time.RFC850
+	RFC1036Layout            = "Mon, 02 Jan 06 15:04:05 -0700"
+	RFC1123Layout            = time.RFC1123
+	RFC1123ZLayout           = time.RFC1123Z
+	RFC2822Layout            = time.RFC1123Z
+	RFC3339Layout            = "2006-01-02T15:04:05Z07:00"
+	RFC3339MilliLayout       = "2006-01-02T15:04:05.999Z07:00"
+	RFC3339MicroLayout       = "2006-01-02T15:04:05.999999Z07:00"
+	RFC3339NanoLayout        = "2006-01-02T15:04:05.999999999Z07:00"
+	RFC5545DateLayout        = "20060102"
+	RFC5545DateTimeLayout    = "20060102T150405"
+	RFC5545DateTimeUTCLayout = "20060102T150405Z"
+	RFC7231Layout            = "Mon, 02 Jan 2006 15:04:05 MST"
+	RFC822Layout             = time.RFC822
+	RFC822ZLayout            = time.RFC822Z
+	RFC850Layout             = time.RFC850
 
 	ISO8601Layout      = "2006-01-02T15:04:05-07:00"
 	ISO8601MilliLayout = "2006-01-02T15:04:05.999-07:00"
@@ -193,18 +219,21 @@ const (
 	RubyDateFormat = "D M d H:i:s O Y"
 	UnixDateFormat = "D M j H:i:s T Y"
 
-	RFC1036Format      = "D, d M y H:i:s O"
-	RFC1123Format      = "D, d M Y H:i:s T"
-	RFC1123ZFormat     = "D, d M Y H:i:s O"
-	RFC2822Format      = "D, d M Y H:i:s O"
-	RFC3339Format      = "Y-m-d\\TH:i:sP"
-	RFC3339MilliFormat = "Y-m-d\\TH:i:s.vP"
-	RFC3339MicroFormat = "Y-m-d\\TH:i:s.uP"
-	RFC3339NanoFormat  = "Y-m-d\\TH:i:s.xP"
-	RFC7231Format      = "D, d M Y H:i:s T"
-	RFC822Format       = "d M y H:i T"
-	RFC822ZFormat      = "d M y H:i O"
-	RFC850Format       = "l, d-M-y H:i:s T"
+	RFC1036Format            = "D, d M y H:i:s O"
+	RFC1123Format            = "D, d M Y H:i:s T"
+	RFC1123ZFormat           = "D, d M Y H:i:s O"
+	RFC2822Format            = "D, d M Y H:i:s O"
+	RFC3339Format            = "Y-m-d\\TH:i:sP"
+	RFC3339MilliFormat       = "Y-m-d\\TH:i:s.vP"
+	RFC3339MicroFormat       = "Y-m-d\\TH:i:s.uP"
+	RFC3339NanoFormat        = "Y-m-d\\TH:i:s.xP"
+	RFC5545DateFormat        = "Ymd"
+	RFC5545DateTimeFormat    = "Ymd\\THis"
+	RFC5545DateTimeUTCFormat = "Ymd\\THis\\Z"
+	RFC7231Format            = "D, d M Y H:i:s T"
+	RFC822Format             = "d M y H:i T"
+	RFC822ZFormat            = "d M y H:i O"
+	RFC850Format             = "l, d-M-y H:i:s T"
 
 	ISO8601Format      = "Y-m-d\\TH:i:sP"
 	ISO8601MilliFormat = "Y-m-d\\TH:i:s.vP"
@@ -247,6 +276,11 @@ type Carbon struct {
 	testNow      int64 // timestamp with nanosecond of test now time
 	tag          string
 	weekStartsAt time.Weekday
+	weekendDays  uint8 // bitmask of weekend days, zero means Saturday and Sunday
+	holidays     HolidayProvider
+	strict       bool // whether to parse time strings strictly
+	calendar     lunarCalendar
+	hijri        string // HijriUmmAlQura or HijriTabular, empty means HijriUmmAlQura
 	loc          *time.Location
 	lang         *Language
 	Error        error
@@ -258,7 +258,7 @@ func (c Carbon) IsWeekday() bool {
 	if c.IsInvalid() {
 		return false
 	}
-	return !c.IsSaturday() && !c.IsSunday()
+	return !c.isWeekendDay(c.ToStdTime().Weekday())
 }
 
 // IsWeekend reports whether is weekend.
@@ -267,7 +267,7 @@ func (c Carbon) IsWeekend() bool {
 	if c.IsInvalid() {
 		return false
 	}
-	return c.IsSaturday() || c.IsSunday()
+	return c.isWeekendDay(c.ToStdTime().Weekday())
 }
 
 // IsYesterday reports whether is yesterday.
@@ -0,0 +1,534 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// max years to search the fire time
+// 查找触发时间的最大年数
+const maxCronYears = 100
+
+var (
+	// cron macros
+	// cron 宏
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	// cron month names
+	// cron 月份名称
+	cronMonths = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+
+	// cron weekday names
+	// cron 星期名称
+	cronWeekdays = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// Cron defines a Cron struct.
+// It supports the standard 5 fields and 6 fields with seconds, macros like "@daily", an optional "CRON_TZ=" prefix,
+// "L", "L-n", "nW" and "LW" in the day of month field, "nL" and "n#k" in the day of week field.
+// The day of week is numbered from 0 (or 7) for Sunday regardless of the week start day of Carbon.
+// The fire times skipped by daylight saving time are moved forward to the end of the gap like "03:00".
+// 定义 Cron 结构体
+type Cron struct {
+	expression string
+	loc        *time.Location
+	seconds    uint64
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+
+	// the day of month and day of week fields are "*" or "?"
+	isAnyDay     bool
+	isAnyWeekday bool
+
+	// "L", "L-n", "nW" and "LW" in the day of month field
+	lastDayOffsets  []int
+	nearestWeekdays []int
+	isLastWeekday   bool
+
+	// "nL" and "n#k" in the day of week field
+	lastWeekdays []int
+	nthWeekdays  [][2]int
+
+	Error error
+}
+
+// ParseCron parses a cron expression like "*/5 * * * *", "0 30 9 * * MON-FRI" or "CRON_TZ=Asia/Shanghai @daily".
+// 解析 cron 表达式
+func ParseCron(expression string) Cron {
+	cr := Cron{expression: expression}
+	spec := strings.TrimSpace(expression)
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.IndexAny(spec, " \t")
+		if i < 0 {
+			cr.Error = invalidCronError(expression)
+			return cr
+		}
+		timezone := spec[strings.Index(spec, "=")+1 : i]
+		if cr.loc, cr.Error = getLocationByTimezone(timezone); cr.Error != nil {
+			return cr
+		}
+		spec = strings.TrimSpace(spec[i:])
+	}
+	if macro, ok := cronMacros[strings.ToLower(spec)]; ok {
+		spec = macro
+	}
+
+	fields := strings.Fields(strings.ToUpper(spec))
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		cr.Error = invalidCronError(expression)
+		return cr
+	}
+
+	var ok bool
+	if cr.seconds, ok = parseCronField(fields[0], 0, 59, nil); !ok {
+		cr.Error = invalidCronError(expression)
+		return cr
+	}
+	if cr.minutes, ok = parseCronField(fields[1], 0, 59, nil); !ok {
+		cr.Error = invalidCronError(expression)
+		return cr
+	}
+	if cr.hours, ok = parseCronField(fields[2], 0, 23, nil); !ok {
+		cr.Error = invalidCronError(expression)
+		return cr
+	}
+	if cr.months, ok = parseCronField(fields[4], 1, 12, cronMonths); !ok {
+		cr.Error = invalidCronError(expression)
+		return cr
+	}
+	if !cr.parseDays(fields[3]) || !cr.parseWeekdays(fields[5]) {
+		cr.Error = invalidCronError(expression)
+		return cr
+	}
+	return cr
+}
+
+// String implements the interface Stringer for Cron struct.
+// 实现 Stringer 接口
+func (cr Cron) String() string {
+	return cr.expression
+}
+
+// Matches reports whether the Carbon instance matches the cron expression, the nanoseconds are ignored.
+// 是否匹配 cron 表达式，忽略纳秒
+func (cr Cron) Matches(c Carbon) bool {
+	if cr.Error != nil || c.IsInvalid() {
+		return false
+	}
+	t := c.time.In(cr.location(c))
+	return cr.matchesDay(t) && hasCronBit(cr.hours, t.Hour()) && hasCronBit(cr.minutes, t.Minute()) && hasCronBit(cr.seconds, t.Second())
+}
+
+// Next gets the next fire time after the Carbon instance, it returns an invalid Carbon instance if there isn't any.
+// 获取下一个触发时间，不存在时返回无效的 Carbon 实例
+func (cr Cron) Next(c Carbon) Carbon {
+	if times := cr.NextN(c, 1); len(times) > 0 {
+		return times[0]
+	}
+	c.time = time.Time{}
+	return c
+}
+
+// NextN gets the next n fire times after the Carbon instance.
+// 获取后 n 个触发时间
+func (cr Cron) NextN(c Carbon, n int) []Carbon {
+	if n <= 0 || cr.Error != nil || c.IsInvalid() {
+		return []Carbon{}
+	}
+	times := make([]Carbon, 0, n)
+	t := c.time.In(cr.location(c)).Truncate(time.Second)
+	for len(times) < n {
+		var ok bool
+		if t, ok = cr.next(t.Add(time.Second)); !ok {
+			break
+		}
+		c.time = t
+		times = append(times, c)
+	}
+	return times
+}
+
+// Prev gets the previous fire time before the Carbon instance, it returns an invalid Carbon instance if there isn't any.
+// 获取上一个触发时间，不存在时返回无效的 Carbon 实例
+func (cr Cron) Prev(c Carbon) Carbon {
+	if times := cr.PrevN(c, 1); len(times) > 0 {
+		return times[0]
+	}
+	c.time = time.Time{}
+	return c
+}
+
+// PrevN gets the previous n fire times before the Carbon instance, the latest one comes first.
+// 获取前 n 个触发时间，最近的排在最前
+func (cr Cron) PrevN(c Carbon, n int) []Carbon {
+	if n <= 0 || cr.Error != nil || c.IsInvalid() {
+		return []Carbon{}
+	}
+	times := make([]Carbon, 0, n)
+	t := c.time.In(cr.location(c))
+	// the fire time equals to the truncated time is before the time if it has nanoseconds
+	if truncated := t.Truncate(time.Second); truncated.Equal(t) {
+		t = t.Add(-time.Second)
+	} else {
+		t = truncated
+	}
+	for len(times) < n {
+		var ok bool
+		if t, ok = cr.prev(t); !ok {
+			break
+		}
+		c.time = t
+		times = append(times, c)
+		t = t.Add(-time.Second)
+	}
+	return times
+}
+
+// gets the location of the cron expression, it is the location of the Carbon instance without "CRON_TZ=" prefix.
+// 获取 cron 表达式的时区，没有 "CRON_TZ=" 前缀时为 Carbon 实例的时区
+func (cr Cron) location(c Carbon) *time.Location {
+	if cr.loc != nil {
+		return cr.loc
+	}
+	return c.loc
+}
+
+// finds the first fire time which is at or after the time.
+// 查找等于或晚于给定时间的第一个触发时间
+func (cr Cron) next(t time.Time) (time.Time, bool) {
+	limit := t.Year() + maxCronYears
+	for t.Year() <= limit {
+		if cr.matchesGap(t) {
+			return t, true
+		}
+		year, month, day := t.Date()
+		hour, minute, second := t.Clock()
+		switch {
+		case !hasCronBit(cr.months, int(month)):
+			t = forwardCronTime(t, year, month+1, 1, 0, 0)
+		case !cr.matchesDay(t):
+			t = forwardCronTime(t, year, month, day+1, 0, 0)
+		case !hasCronBit(cr.hours, hour):
+			t = forwardCronTime(t, year, month, day, hour+1, 0)
+		case !hasCronBit(cr.minutes, minute):
+			t = forwardCronTime(t, year, month, day, hour, minute+1)
+		case !hasCronBit(cr.seconds, second):
+			t = t.Add(time.Second)
+		default:
+			return t, true
+		}
+	}
+	return t, false
+}
+
+// finds the last fire time which is at or before the time.
+// 查找等于或早于给定时间的最后一个触发时间
+func (cr Cron) prev(t time.Time) (time.Time, bool) {
+	loc, limit := t.Location(), t.Year()-maxCronYears
+	for t.Year() >= limit {
+		if cr.matchesGap(t) {
+			return t, true
+		}
+		year, month, day := t.Date()
+		hour, minute, _ := t.Clock()
+		last := t
+		switch {
+		case !hasCronBit(cr.months, int(month)):
+			t = getCronTime(year, month, 1, 0, 0, loc).Add(-time.Second)
+		case !cr.matchesDay(t):
+			t = getCronTime(year, month, day, 0, 0, loc).Add(-time.Second)
+		case !hasCronBit(cr.hours, hour):
+			t = getCronTime(year, month, day, hour, 0, loc).Add(-time.Second)
+		case !hasCronBit(cr.minutes, minute):
+			t = getCronTime(year, month, day, hour, minute, loc).Add(-time.Second)
+		case !hasCronBit(cr.seconds, t.Second()):
+			t = t.Add(-time.Second)
+		default:
+			return t, true
+		}
+		// the end of the gap is passed over if the time is moved back across daylight saving time
+		if gap, ok := getCronGap(t, last); ok && cr.matchesGap(gap) {
+			return gap, true
+		}
+	}
+	return t, false
+}
+
+// reports whether the time is the end of a daylight saving time gap and any skipped wall clock in the gap matches.
+// 时间是否是夏令时间隙的结束时间且间隙中被跳过的挂钟时间是否匹配
+func (cr Cron) matchesGap(t time.Time) bool {
+	_, offset := t.Zone()
+	_, before := t.Add(-time.Second).Zone()
+	if offset <= before {
+		return false
+	}
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	end := time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+	for wall := end.Add(-time.Duration(offset-before) * time.Second); wall.Before(end); wall = wall.Add(time.Second) {
+		if hasCronBit(cr.months, int(wall.Month())) && cr.matchesDay(wall) && hasCronBit(cr.hours, wall.Hour()) && hasCronBit(cr.minutes, wall.Minute()) && hasCronBit(cr.seconds, wall.Second()) {
+			return true
+		}
+	}
+	return false
+}
+
+// reports whether the day matches the day of month and day of week fields,
+// either of them matching is enough if both of them are restricted.
+// 日期是否匹配日和星期字段，两者都有限制时匹配其一即可
+func (cr Cron) matchesDay(t time.Time) bool {
+	day, weekday := cr.matchesDayOfMonth(t), cr.matchesDayOfWeek(t)
+	if cr.isAnyDay || cr.isAnyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
+
+// reports whether the day matches the day of month field.
+// 日期是否匹配日字段
+func (cr Cron) matchesDayOfMonth(t time.Time) bool {
+	if hasCronBit(cr.days, t.Day()) {
+		return true
+	}
+	year, month, day := t.Date()
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, offset := range cr.lastDayOffsets {
+		if day == last-offset {
+			return true
+		}
+	}
+	for _, nearest := range cr.nearestWeekdays {
+		if nearest <= last && day == getCronNearestWeekday(year, month, nearest, last) {
+			return true
+		}
+	}
+	return cr.isLastWeekday && day == getCronNearestWeekday(year, month, last, last)
+}
+
+// reports whether the day matches the day of week field.
+// 日期是否匹配星期字段
+func (cr Cron) matchesDayOfWeek(t time.Time) bool {
+	weekday := int(t.Weekday())
+	if hasCronBit(cr.weekdays, weekday) {
+		return true
+	}
+	year, month, day := t.Date()
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	for _, w := range cr.lastWeekdays {
+		if w == weekday && day+DaysPerWeek > last {
+			return true
+		}
+	}
+	for _, nth := range cr.nthWeekdays {
+		if nth[0] == weekday && (day-1)/DaysPerWeek+1 == nth[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// parses the day of month field.
+// 解析日字段
+func (cr *Cron) parseDays(field string) bool {
+	cr.isAnyDay = field[0] == '*' || field[0] == '?'
+	parts := make([]string, 0)
+	for _, part := range strings.Split(field, ",") {
+		switch {
+		case part == "L":
+			cr.lastDayOffsets = append(cr.lastDayOffsets, 0)
+		case part == "LW":
+			cr.isLastWeekday = true
+		case strings.HasPrefix(part, "L-"):
+			offset, err := strconv.Atoi(part[2:])
+			if err != nil || offset < 0 || offset > 30 {
+				return false
+			}
+			cr.lastDayOffsets = append(cr.lastDayOffsets, offset)
+		case strings.HasSuffix(part, "W"):
+			day, err := strconv.Atoi(strings.TrimSuffix(part, "W"))
+			if err != nil || day < 1 || day > 31 {
+				return false
+			}
+			cr.nearestWeekdays = append(cr.nearestWeekdays, day)
+		default:
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return true
+	}
+	var ok bool
+	cr.days, ok = parseCronField(strings.Join(parts, ","), 1, 31, nil)
+	return ok
+}
+
+// parses the day of week field, 7 is also Sunday.
+// 解析星期字段，7 也表示周日
+func (cr *Cron) parseWeekdays(field string) bool {
+	cr.isAnyWeekday = field[0] == '*' || field[0] == '?'
+	parts := make([]string, 0)
+	for _, part := range strings.Split(field, ",") {
+		switch {
+		case strings.Contains(part, "#"):
+			kv := strings.SplitN(part, "#", 2)
+			weekday, ok := parseCronValue(kv[0], 0, 7, cronWeekdays)
+			nth, err := strconv.Atoi(kv[1])
+			if !ok || err != nil || nth < 1 || nth > 5 {
+				return false
+			}
+			cr.nthWeekdays = append(cr.nthWeekdays, [2]int{weekday % DaysPerWeek, nth})
+		case len(part) > 1 && strings.HasSuffix(part, "L"):
+			weekday, ok := parseCronValue(strings.TrimSuffix(part, "L"), 0, 7, cronWeekdays)
+			if !ok {
+				return false
+			}
+			cr.lastWeekdays = append(cr.lastWeekdays, weekday%DaysPerWeek)
+		default:
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return true
+	}
+	bits, ok := parseCronField(strings.Join(parts, ","), 0, 7, cronWeekdays)
+	if bits&(1<<7) != 0 {
+		bits |= 1
+	}
+	cr.weekdays = bits &^ (1 << 7)
+	return ok
+}
+
+// parses a field like "*/5", "1-10/2", "MON-FRI" or "1,15" as a bitset.
+// 解析字段为位集合
+func parseCronField(field string, min, max int, names map[string]int) (uint64, bool) {
+	bits := uint64(0)
+	for _, part := range strings.Split(field, ",") {
+		step, ranges := 1, part
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, false
+			}
+			step, ranges = n, part[:i]
+		}
+		var low, high int
+		var ok bool
+		switch {
+		case ranges == "*" || ranges == "?":
+			low, high, ok = min, max, true
+		case strings.Contains(ranges, "-"):
+			kv := strings.SplitN(ranges, "-", 2)
+			var ok1, ok2 bool
+			low, ok1 = parseCronValue(kv[0], min, max, names)
+			high, ok2 = parseCronValue(kv[1], min, max, names)
+			ok = ok1 && ok2 && low <= high
+		default:
+			low, ok = parseCronValue(ranges, min, max, names)
+			high = low
+			if step > 1 || strings.Contains(part, "/") {
+				high = max
+			}
+		}
+		if !ok {
+			return 0, false
+		}
+		for i := low; i <= high; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, true
+}
+
+// parses a value like "5" or "MON".
+// 解析值
+func parseCronValue(value string, min, max int, names map[string]int) (int, bool) {
+	if n, ok := names[value]; ok {
+		return n, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, false
+	}
+	return n, true
+}
+
+// reports whether the bit is set.
+// 是否设置了某位
+func hasCronBit(bits uint64, i int) bool {
+	return bits&(1<<uint(i)) != 0
+}
+
+// gets the nearest weekday to the day in the month, it never crosses the month.
+// 获取本月距离给定日期最近的工作日，不会跨月
+func getCronNearestWeekday(year int, month time.Month, day, last int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// forwards the time to the boundary, it moves one second at least.
+// 前进到边界，至少前进一秒
+func forwardCronTime(t time.Time, year int, month time.Month, day, hour, minute int) time.Time {
+	if boundary := getCronTime(year, month, day, hour, minute, t.Location()); boundary.After(t) {
+		return boundary
+	}
+	return t.Add(time.Second)
+}
+
+// gets the end of the daylight saving time gap which is after the start and at or before the end.
+// 获取晚于开始时间且不晚于结束时间的夏令时间隙结束时间
+func getCronGap(start, end time.Time) (time.Time, bool) {
+	_, from := start.Zone()
+	_, to := end.Zone()
+	if to <= from {
+		return end, false
+	}
+	// the first time with the offset of the end is searched by seconds
+	low, high := start.Unix(), end.Unix()
+	for high-low > 1 {
+		middle := low + (high-low)/2
+		if _, offset := time.Unix(middle, 0).In(end.Location()).Zone(); offset == to {
+			high = middle
+		} else {
+			low = middle
+		}
+	}
+	return time.Unix(high, 0).In(end.Location()), true
+}
+
+// gets the time by the wall clock, a nonexistent time because of daylight saving time is moved forward by the gap.
+// 根据挂钟时间获取时间，因夏令时不存在的时间会向后移动
+func getCronTime(year int, month time.Month, day, hour, minute int, loc *time.Location) time.Time {
+	return getWallClockTime(time.Date(year, month, day, hour, minute, 0, 0, time.UTC), loc)
+}
@@ -0,0 +1,44 @@
+package carbon
+
+import "testing"
+
+func BenchmarkParseCron(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		ParseCron("CRON_TZ=Asia/Shanghai 0 30 9 1,15 * MON-FRI")
+	}
+}
+
+func BenchmarkCron_Matches(b *testing.B) {
+	cr, now := ParseCron("0 30 9 * * MON-FRI"), Now()
+	for n := 0; n < b.N; n++ {
+		cr.Matches(now)
+	}
+}
+
+func BenchmarkCron_Next(b *testing.B) {
+	cr, now := ParseCron("0 0 L * *"), Now()
+	for n := 0; n < b.N; n++ {
+		cr.Next(now)
+	}
+}
+
+func BenchmarkCron_NextN(b *testing.B) {
+	cr, now := ParseCron("*/15 * * * *"), Now()
+	for n := 0; n < b.N; n++ {
+		cr.NextN(now, 10)
+	}
+}
+
+func BenchmarkCron_Prev(b *testing.B) {
+	cr, now := ParseCron("0 0 L * *"), Now()
+	for n := 0; n < b.N; n++ {
+		cr.Prev(now)
+	}
+}
+
+func BenchmarkCron_PrevN(b *testing.B) {
+	cr, now := ParseCron("*/15 * * * *"), Now()
+	for n := 0; n < b.N; n++ {
+		cr.PrevN(now, 10)
+	}
+}
@@ -0,0 +1,163 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func toCronStrings(times []Carbon) []string {
+	strs := make([]string, len(times))
+	for i, t := range times {
+		strs[i] = t.ToRfc3339String()
+	}
+	return strs
+}
+
+func TestCron_NextN(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		expression string
+		input      Carbon
+		expected   []string
+	}{
+		0:  {"*/15 * * * *", Parse("2020-08-05 13:14:15", UTC), []string{"2020-08-05T13:15:00Z", "2020-08-05T13:30:00Z", "2020-08-05T13:45:00Z"}},
+		1:  {"*/20 * * * * *", Parse("2020-08-05 13:14:15", UTC), []string{"2020-08-05T13:14:20Z", "2020-08-05T13:14:40Z", "2020-08-05T13:15:00Z"}},
+		2:  {"0 9 * * MON-FRI", Parse("2020-08-07 13:14:15", UTC), []string{"2020-08-10T09:00:00Z", "2020-08-11T09:00:00Z", "2020-08-12T09:00:00Z"}},
+		3:  {"@daily", Parse("2020-08-05 13:14:15", PRC), []string{"2020-08-06T00:00:00+08:00", "2020-08-07T00:00:00+08:00", "2020-08-08T00:00:00+08:00"}},
+		4:  {"@weekly", Parse("2020-08-05 13:14:15", UTC), []string{"2020-08-09T00:00:00Z", "2020-08-16T00:00:00Z", "2020-08-23T00:00:00Z"}},
+		5:  {"@monthly", Parse("2020-08-05 13:14:15", UTC), []string{"2020-09-01T00:00:00Z", "2020-10-01T00:00:00Z", "2020-11-01T00:00:00Z"}},
+		6:  {"@yearly", Parse("2020-08-05 13:14:15", UTC), []string{"2021-01-01T00:00:00Z", "2022-01-01T00:00:00Z", "2023-01-01T00:00:00Z"}},
+		7:  {"@hourly", Parse("2020-08-05 13:14:15", UTC), []string{"2020-08-05T14:00:00Z", "2020-08-05T15:00:00Z", "2020-08-05T16:00:00Z"}},
+		8:  {"0 0 L * *", Parse("2020-01-05", UTC), []string{"2020-01-31T00:00:00Z", "2020-02-29T00:00:00Z", "2020-03-31T00:00:00Z"}},
+		9:  {"0 0 L-2 * ?", Parse("2021-01-05", UTC), []string{"2021-01-29T00:00:00Z", "2021-02-26T00:00:00Z", "2021-03-29T00:00:00Z"}},
+		10: {"0 0 LW * *", Parse("2020-01-05", UTC), []string{"2020-01-31T00:00:00Z", "2020-02-28T00:00:00Z", "2020-03-31T00:00:00Z"}},
+		11: {"0 0 15W * *", Parse("2020-08-01", UTC), []string{"2020-08-14T00:00:00Z", "2020-09-15T00:00:00Z", "2020-10-15T00:00:00Z"}},
+		12: {"0 0 1W * *", Parse("2020-08-01", UTC), []string{"2020-08-03T00:00:00Z", "2020-09-01T00:00:00Z", "2020-10-01T00:00:00Z"}},
+		13: {"0 0 31W * *", Parse("2020-05-01", UTC), []string{"2020-05-29T00:00:00Z", "2020-07-31T00:00:00Z", "2020-08-31T00:00:00Z"}},
+		14: {"0 0 * * 5L", Parse("2020-08-01", UTC), []string{"2020-08-28T00:00:00Z", "2020-09-25T00:00:00Z", "2020-10-30T00:00:00Z"}},
+		15: {"0 0 ? * FRI#2", Parse("2020-08-01", UTC), []string{"2020-08-14T00:00:00Z", "2020-09-11T00:00:00Z", "2020-10-09T00:00:00Z"}},
+		16: {"0 0 13 * 5", Parse("2020-08-01", UTC), []string{"2020-08-07T00:00:00Z", "2020-08-13T00:00:00Z", "2020-08-14T00:00:00Z"}},
+		17: {"0 0 29 2 *", Parse("2020-08-01", UTC), []string{"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z"}},
+		18: {"30 9 1,15 jan-mar/2 7", Parse("2020-08-01", UTC), []string{"2021-01-01T09:30:00Z", "2021-01-03T09:30:00Z", "2021-01-10T09:30:00Z"}},
+		19: {"CRON_TZ=Asia/Shanghai 0 9 * * *", Parse("2020-08-05 13:14:15", UTC), []string{"2020-08-06T01:00:00Z", "2020-08-07T01:00:00Z", "2020-08-08T01:00:00Z"}},
+		20: {"TZ=UTC 0 9 * * *", Parse("2020-08-05 13:14:15", PRC), []string{"2020-08-05T17:00:00+08:00", "2020-08-06T17:00:00+08:00", "2020-08-07T17:00:00+08:00"}},
+		21: {"30 2 * * *", Parse("2024-03-09 00:00:00", NewYork), []string{"2024-03-09T02:30:00-05:00", "2024-03-10T03:00:00-04:00", "2024-03-11T02:30:00-04:00"}},
+		22: {"0 * * * *", Parse("2024-03-10 00:30:00", NewYork), []string{"2024-03-10T01:00:00-05:00", "2024-03-10T03:00:00-04:00", "2024-03-10T04:00:00-04:00"}},
+		23: {"30 1 * * *", Parse("2024-11-02 12:00:00", NewYork), []string{"2024-11-03T01:30:00-04:00", "2024-11-04T01:30:00-05:00", "2024-11-05T01:30:00-05:00"}},
+		24: {"0 0 30 2 *", Parse("2020-08-01", UTC), []string{}},
+		25: {"* * * * *", Parse(""), []string{}},
+		26: {"0 2 * * *", Parse("2024-03-09 12:00:00", NewYork), []string{"2024-03-10T03:00:00-04:00", "2024-03-11T02:00:00-04:00", "2024-03-12T02:00:00-04:00"}},
+		27: {"30 2 * * *", Parse("2024-03-10 03:00:00", NewYork), []string{"2024-03-11T02:30:00-04:00", "2024-03-12T02:30:00-04:00", "2024-03-13T02:30:00-04:00"}},
+		28: {"*/20 2 * * *", Parse("2024-03-10 00:00:00", NewYork), []string{"2024-03-10T03:00:00-04:00", "2024-03-11T02:00:00-04:00", "2024-03-11T02:20:00-04:00"}},
+	}
+
+	for index, test := range tests {
+		cr := ParseCron(test.expression)
+		assert.Nil(cr.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, toCronStrings(cr.NextN(test.input, 3)), "Current test index is "+strconv.Itoa(index))
+	}
+	assert.Empty(ParseCron("* * * * *").NextN(Parse("2020-08-05 13:14:15"), 0))
+	assert.Empty(ParseCron("* * * * *").NextN(Parse("2020-08-05 13:14:15"), -1))
+}
+
+func TestCron_PrevN(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		expression string
+		input      Carbon
+		expected   []string
+	}{
+		0:  {"*/15 * * * *", Parse("2020-08-05 13:15:00", UTC), []string{"2020-08-05T13:00:00Z", "2020-08-05T12:45:00Z", "2020-08-05T12:30:00Z"}},
+		1:  {"*/15 * * * *", Parse("2020-08-05 13:15:00.1", UTC), []string{"2020-08-05T13:15:00Z", "2020-08-05T13:00:00Z", "2020-08-05T12:45:00Z"}},
+		2:  {"0 9 * * MON-FRI", Parse("2020-08-10 08:00:00", UTC), []string{"2020-08-07T09:00:00Z", "2020-08-06T09:00:00Z", "2020-08-05T09:00:00Z"}},
+		3:  {"0 0 L * *", Parse("2020-04-05", UTC), []string{"2020-03-31T00:00:00Z", "2020-02-29T00:00:00Z", "2020-01-31T00:00:00Z"}},
+		4:  {"0 0 ? * FRI#2", Parse("2020-10-01", UTC), []string{"2020-09-11T00:00:00Z", "2020-08-14T00:00:00Z", "2020-07-10T00:00:00Z"}},
+		5:  {"0 0 29 2 *", Parse("2020-08-01", UTC), []string{"2020-02-29T00:00:00Z", "2016-02-29T00:00:00Z", "2012-02-29T00:00:00Z"}},
+		6:  {"0 * * * *", Parse("2024-03-10 04:30:00", NewYork), []string{"2024-03-10T04:00:00-04:00", "2024-03-10T03:00:00-04:00", "2024-03-10T01:00:00-05:00"}},
+		7:  {"30 2 * * *", Parse("2024-03-12 00:00:00", NewYork), []string{"2024-03-11T02:30:00-04:00", "2024-03-10T03:00:00-04:00", "2024-03-09T02:30:00-05:00"}},
+		8:  {"CRON_TZ=Asia/Shanghai 0 9 * * *", Parse("2020-08-05 13:14:15", UTC), []string{"2020-08-05T01:00:00Z", "2020-08-04T01:00:00Z", "2020-08-03T01:00:00Z"}},
+		9:  {"0 0 30 2 *", Parse("2020-08-01", UTC), []string{}},
+		10: {"* * * * *", Parse(""), []string{}},
+		11: {"0 2 * * *", Parse("2024-03-10 03:00:01", NewYork), []string{"2024-03-10T03:00:00-04:00", "2024-03-09T02:00:00-05:00", "2024-03-08T02:00:00-05:00"}},
+		12: {"30 2 * * *", Parse("2024-03-10 02:59:59", NewYork), []string{"2024-03-09T02:30:00-05:00", "2024-03-08T02:30:00-05:00", "2024-03-07T02:30:00-05:00"}},
+	}
+
+	for index, test := range tests {
+		cr := ParseCron(test.expression)
+		assert.Nil(cr.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, toCronStrings(cr.PrevN(test.input, 3)), "Current test index is "+strconv.Itoa(index))
+	}
+	assert.Empty(ParseCron("* * * * *").PrevN(Parse("2020-08-05 13:14:15"), 0))
+	assert.Empty(ParseCron("* * * * *").PrevN(Parse("2020-08-05 13:14:15"), -1))
+}
+
+func TestCron_Next(t *testing.T) {
+	assert := assert.New(t)
+
+	cr := ParseCron("0 9 * * *")
+	c := SetLocale("zh-CN").Parse("2020-08-05 13:14:15", PRC)
+	assert.Equal("2020-08-06 09:00:00", cr.Next(c).ToDateTimeString())
+	assert.Equal("zh-CN", cr.Next(c).Locale())
+	assert.Equal(PRC, cr.Next(c).Location())
+	assert.True(ParseCron("0 0 30 2 *").Next(c).IsInvalid())
+	assert.True(cr.Next(Parse("")).IsInvalid())
+}
+
+func TestCron_Prev(t *testing.T) {
+	assert := assert.New(t)
+
+	cr := ParseCron("0 9 * * *")
+	c := Parse("2020-08-05 13:14:15", PRC)
+	assert.Equal("2020-08-05 09:00:00", cr.Prev(c).ToDateTimeString())
+	assert.True(ParseCron("0 0 30 2 *").Prev(c).IsInvalid())
+	assert.True(cr.Prev(Parse("")).IsInvalid())
+}
+
+func TestCron_Matches(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		expression string
+		input      Carbon
+		expected   bool
+	}{
+		0: {"* * * * *", Parse(""), false},
+		1: {"14 13 * * *", Parse("2020-08-05 13:14:00", UTC), true},
+		2: {"14 13 * * *", Parse("2020-08-05 13:14:15", UTC), false},
+		3: {"15 14 13 * * WED", Parse("2020-08-05 13:14:15.999", UTC), true},
+		4: {"0 0 L 2 *", Parse("2020-02-29", UTC), true},
+		5: {"0 0 L 2 *", Parse("2020-02-28", UTC), false},
+		6: {"CRON_TZ=Asia/Shanghai 0 21 * * *", Parse("2020-08-05 13:00:00", UTC), true},
+		7: {"0 0 * * 0", Parse("2020-08-09", UTC), true},
+		8: {"0 0 * * 7", Parse("2020-08-09", UTC).SetWeekStartsAt(Monday), true},
+	}
+
+	for index, test := range tests {
+		cr := ParseCron(test.expression)
+		assert.Nil(cr.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, cr.Matches(test.input), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCron_String(t *testing.T) {
+	assert.Equal(t, "CRON_TZ=Asia/Shanghai @daily", ParseCron("CRON_TZ=Asia/Shanghai @daily").String())
+}
+
+func TestError_Cron(t *testing.T) {
+	expressions := []string{
+		"", "@xxx", "* * * *", "* * * * * * *", "CRON_TZ=xxx * * * * *", "CRON_TZ=UTC", "60 * * * *", "* 24 * * *", "* * 0 * *",
+		"* * 32 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a-1 * * * *", "* * L-31 * *", "* * L-x * *",
+		"* * 32W * *", "* * xW * *", "* * * * 5#6", "* * * * x#1", "* * * * xL", "* * * * xxx", "1/x * * * *",
+	}
+	for index, expression := range expressions {
+		cr := ParseCron(expression)
+		assert.NotNil(t, cr.Error, "It should catch an exception in ParseCron(), current test index is "+strconv.Itoa(index))
+		assert.False(t, cr.Matches(Now()))
+		assert.Empty(t, cr.NextN(Now(), 1))
+		assert.Empty(t, cr.PrevN(Now(), 1))
+	}
+}
@@ -24,6 +24,35 @@ func (c Carbon) Value() (driver.Value, error) {
 	return c.ToStdTime(), nil
 }
 
+// Scan an interface used by Scan in package database/sql for Scanning value from database to local golang variable,
+// the value can be a lunar date string like "2020-04L-15 13:14:15" or a time.Time.
+func (l *LunarDate) Scan(v interface{}) error {
+	switch value := v.(type) {
+	case nil:
+		*l = LunarDate{}
+	case string:
+		*l = parseLunarDate(value)
+	case []byte:
+		*l = parseLunarDate(string(value))
+	case time.Time:
+		*l = CreateFromStdTime(value).Lunar()
+	default:
+		return fmt.Errorf("can not convert %v to lunar date", v)
+	}
+	return l.Error
+}
+
+// Value the interface providing the Value method for package database/sql/driver, the lunar date is stored as a string.
+func (l LunarDate) Value() (driver.Value, error) {
+	if l.Error != nil {
+		return nil, l.Error
+	}
+	if l.IsInvalid() {
+		return nil, nil
+	}
+	return l.toLeapString(), nil
+}
+
 // GormDataType implements the interface GormDataTypeInterface for Carbon struct.
 // 实现 GormDataTypeInterface 接口
 func (c Carbon) GormDataType() string {
@@ -22,3 +22,17 @@ func BenchmarkCarbon_GormDataType(b *testing.B) {
 		now.GormDataType()
 	}
 }
+
+func BenchmarkLunar_Scan(b *testing.B) {
+	var l LunarDate
+	for n := 0; n < b.N; n++ {
+		_ = l.Scan("2020-04L-10 13:14:15")
+	}
+}
+
+func BenchmarkLunar_Value(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.Value()
+	}
+}
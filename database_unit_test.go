@@ -2,6 +2,7 @@ package carbon
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -38,3 +39,46 @@ func TestError_Value(t *testing.T) {
 func TestCarbon_GormDataType(t *testing.T) {
 	assert.Equal(t, "time", NewCarbon().GormDataType())
 }
+
+func TestLunar_Scan(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    interface{}
+		expected string
+	}{
+		0: {nil, ""},
+		1: {"2020-04L-10 13:14:15", "2020-04L-10 13:14:15"},
+		2: {[]byte("2020-06-16 13:14:15"), "2020-06-16 13:14:15"},
+		3: {Parse("2020-08-05 13:14:15", PRC).ToStdTime(), "2020-06-16 13:14:15"},
+	}
+
+	for index, test := range tests {
+		var l LunarDate
+		assert.Nil(l.Scan(test.input), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, l.toLeapString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestLunar_Value(t *testing.T) {
+	assert := assert.New(t)
+
+	v, err := Parse("2020-06-01 13:14:15", PRC).Lunar().Value()
+	assert.Nil(err)
+	assert.Equal("2020-04L-10 13:14:15", v)
+
+	v, err = LunarDate{}.Value()
+	assert.Nil(err)
+	assert.Nil(v)
+}
+
+func TestError_LunarScan(t *testing.T) {
+	assert := assert.New(t)
+
+	var l LunarDate
+	assert.Equal(fmt.Errorf("can not convert %v to lunar date", 1), l.Scan(1))
+	assert.NotNil(l.Scan("xxx"))
+
+	_, err := Parse("2500-08-05").Lunar().Value()
+	assert.NotNil(err)
+}
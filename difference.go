@@ -217,6 +217,33 @@ func (c Carbon) DiffForHumans(carbon ...Carbon) string {
 	return strings.Replace(c.lang.resources["after"], "%s", translation, 1)
 }
 
+// DiffNoOverflow gets the difference as an Interval instance without overflowing month,
+// adding it to the current time with AddNoOverflow gives exactly the end time.
+// 获取相差时长(月份不溢出)，使用 AddNoOverflow 将其加到当前时间上正好得到结束时间
+func (c Carbon) DiffNoOverflow(carbon ...Carbon) Interval {
+	end := c.Now()
+	if len(carbon) > 0 {
+		end = carbon[0]
+	}
+	return c.diffInterval(end, Carbon.AddMonthsNoOverflow)
+}
+
+// DiffInDetailString gets the difference in detail like "1 year 2 months 3 days 4 hours" without overflowing month, i18n is supported.
+// 获取详细的相差字符串(月份不溢出)，支持i18n
+func (c Carbon) DiffInDetailString(carbon ...Carbon) string {
+	return c.DiffNoOverflow(carbon...).ForHumans()
+}
+
+// DiffAbsInDetailString gets the difference in detail like "1 year 2 months 3 days 4 hours" with absolute value without overflowing month, i18n is supported.
+// 获取详细的相差字符串(月份不溢出)，支持i18n(绝对值)
+func (c Carbon) DiffAbsInDetailString(carbon ...Carbon) string {
+	i := c.DiffNoOverflow(carbon...)
+	if i.isNegative() {
+		i = i.negate()
+	}
+	return i.ForHumans()
+}
+
 // gets the difference for unit and value.
 // 获取相差单位和差值
 func (c Carbon) diff(end Carbon) (unit string, value int64) {
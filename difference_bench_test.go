@@ -120,3 +120,24 @@ func BenchmarkCarbon_DiffForHumans(b *testing.B) {
 		now.DiffForHumans(Yesterday())
 	}
 }
+
+func BenchmarkCarbon_DiffNoOverflow(b *testing.B) {
+	start, end := Parse("2020-01-31 13:14:15"), Parse("2021-10-15 15:44:15")
+	for n := 0; n < b.N; n++ {
+		start.DiffNoOverflow(end)
+	}
+}
+
+func BenchmarkCarbon_DiffInDetailString(b *testing.B) {
+	start, end := Parse("2020-01-31 13:14:15"), Parse("2021-10-15 15:44:15")
+	for n := 0; n < b.N; n++ {
+		start.DiffInDetailString(end)
+	}
+}
+
+func BenchmarkCarbon_DiffAbsInDetailString(b *testing.B) {
+	start, end := Parse("2020-01-31 13:14:15"), Parse("2021-10-15 15:44:15")
+	for n := 0; n < b.N; n++ {
+		start.DiffAbsInDetailString(end)
+	}
+}
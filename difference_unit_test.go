@@ -530,3 +530,79 @@ func TestLangError_DiffForHumans(t *testing.T) {
 	assert.NotNil(t, c.Error, "It should catch an exception in DiffForHumans()")
 	assert.Equal(t, "", c.DiffForHumans())
 }
+
+func TestCarbon_DiffNoOverflow(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input1, input2 string
+		expected       string
+	}{
+		0: {"2020-08-05 13:14:15", "2020-08-05 13:14:15", "PT0S"},
+		1: {"2020-08-05 13:14:15", "2021-10-15 15:44:15", "P1Y2M10DT2H30M"},
+		2: {"2021-10-15 15:44:15", "2020-08-05 13:14:15", "-P1Y2M10DT2H30M"},
+		3: {"2020-01-31 00:00:00", "2020-02-29 00:00:00", "P1M"},
+		4: {"2020-01-31 00:00:00", "2020-03-01 00:00:00", "P1M1D"},
+		5: {"2020-01-31 00:00:00", "2020-03-31 00:00:00", "P2M"},
+		6: {"2020-02-29 00:00:00", "2021-02-28 00:00:00", "P1Y"},
+		7: {"2020-03-31 00:00:00", "2020-02-29 00:00:00", "-P1M"},
+		8: {"2019-06-15 08:00:00", "2020-08-18 12:00:00", "P1Y2M3DT4H"},
+	}
+
+	for index, test := range tests {
+		c1, c2 := Parse(test.input1), Parse(test.input2)
+		i := c1.DiffNoOverflow(c2)
+		assert.Nil(i.Error)
+		assert.Equal(test.expected, i.ToIso8601String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(c2.ToDateTimeString(), c1.AddNoOverflow(i).ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	var c Carbon
+	c.SetTestNow(Parse("2020-08-05 13:14:15"))
+	assert.Equal("-P1M", c.Parse("2020-09-05 13:14:15").DiffNoOverflow().ToIso8601String())
+}
+
+func TestCarbon_DiffInDetailString(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input1, input2 string
+		locale         string
+		expected       string
+	}{
+		0: {"2019-06-15 08:00:00", "2020-08-18 12:00:00", "en", "1 year 2 months 3 days 4 hours"},
+		1: {"2020-08-18 12:00:00", "2019-06-15 08:00:00", "en", "-1 year -2 months -3 days -4 hours"},
+		2: {"2019-06-15 08:00:00", "2020-08-18 12:00:00", "zh-CN", "1 年 2 个月 3 天 4 小时"},
+		3: {"2020-08-05 13:14:15", "2020-08-05 13:14:15", "en", "0 seconds"},
+	}
+
+	for index, test := range tests {
+		c1, c2 := Parse(test.input1).SetLocale(test.locale), Parse(test.input2)
+		assert.Nil(c1.Error)
+		assert.Equal(test.expected, c1.DiffInDetailString(c2), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_DiffAbsInDetailString(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input1, input2 string
+		expected       string
+	}{
+		0: {"2019-06-15 08:00:00", "2020-08-18 12:00:00", "1 year 2 months 3 days 4 hours"},
+		1: {"2020-08-18 12:00:00", "2019-06-15 08:00:00", "1 year 2 months 3 days 4 hours"},
+	}
+
+	for index, test := range tests {
+		c1, c2 := Parse(test.input1), Parse(test.input2)
+		assert.Nil(c1.Error)
+		assert.Equal(test.expected, c1.DiffAbsInDetailString(c2), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestError_DiffNoOverflow(t *testing.T) {
+	assert.NotNil(t, Parse("xxx").DiffNoOverflow(Parse("2020-08-05")).Error, "It should catch an exception in DiffNoOverflow()")
+	assert.Equal(t, "", Parse("2020-08-05").DiffInDetailString(Parse("xxx")), "It should catch an exception in DiffInDetailString()")
+	assert.Equal(t, "", Parse("xxx").DiffAbsInDetailString(Parse("2020-08-05")), "It should catch an exception in DiffAbsInDetailString()")
+}
@@ -40,3 +40,22 @@ func (c *Carbon) UnmarshalJSON(b []byte) error {
 	}
 	return c.Error
 }
+
+// MarshalJSON implements the interface Marshaler for LunarDate struct.
+// 实现 Marshaler 接口
+func (l LunarDate) MarshalJSON() ([]byte, error) {
+	if l.Error != nil {
+		return nil, l.Error
+	}
+	if l.IsInvalid() {
+		return []byte("null"), nil
+	}
+	return []byte(fmt.Sprintf(`"%s"`, l.toLeapString())), nil
+}
+
+// UnmarshalJSON implements the interface Unmarshaler for LunarDate struct.
+// 实现 Unmarshaler 接口
+func (l *LunarDate) UnmarshalJSON(b []byte) error {
+	*l = parseLunarDate(fmt.Sprintf("%s", bytes.Trim(b, `"`)))
+	return l.Error
+}
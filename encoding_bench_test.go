@@ -15,3 +15,18 @@ func BenchmarkCarbon_UnmarshalJSON(b *testing.B) {
 		now.UnmarshalJSON(nil)
 	}
 }
+
+func BenchmarkLunar_MarshalJSON(b *testing.B) {
+	l := Now().Lunar()
+	for n := 0; n < b.N; n++ {
+		l.MarshalJSON()
+	}
+}
+
+func BenchmarkLunar_UnmarshalJSON(b *testing.B) {
+	l := Now().Lunar()
+	data := []byte(`"2020-04L-10 13:14:15"`)
+	for n := 0; n < b.N; n++ {
+		l.UnmarshalJSON(data)
+	}
+}
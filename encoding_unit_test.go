@@ -3,6 +3,7 @@ package carbon
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -387,3 +388,76 @@ func TestError_Json(t *testing.T) {
 	fmt.Println("unmarshal error:", unmarshalErr.Error())
 	assert.NotNil(t, unmarshalErr)
 }
+
+func TestLunar_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	type Festival struct {
+		Name string    `json:"name"`
+		Date LunarDate `json:"date"`
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"", `{"name":"xxx","date":null}`},
+		1: {"2020-08-05 13:14:15", `{"name":"xxx","date":"2020-06-16 13:14:15"}`},
+		2: {"2020-06-01 00:00:00", `{"name":"xxx","date":"2020-04L-10 00:00:00"}`},
+	}
+
+	for index, test := range tests {
+		data, err := json.Marshal(Festival{Name: "xxx", Date: Parse(test.input, PRC).Lunar()})
+		assert.Nil(err)
+		assert.Equal(test.expected, string(data), "Current test index is "+strconv.Itoa(index))
+	}
+
+	data, err := json.Marshal(Festival{Name: "xxx"})
+	assert.Nil(err)
+	assert.Equal(`{"name":"xxx","date":null}`, string(data))
+}
+
+func TestLunar_UnmarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	type Festival struct {
+		Name string    `json:"name"`
+		Date LunarDate `json:"date"`
+	}
+
+	tests := []struct {
+		input    string
+		expected string
+		leap     bool
+	}{
+		0: {`{"date":""}`, "", false},
+		1: {`{"date":null}`, "", false},
+		2: {`{"date":"2020-06-16 13:14:15"}`, "2020-08-05 13:14:15", false},
+		3: {`{"date":"2020-04L-10 00:00:00"}`, "2020-06-01 00:00:00", true},
+		4: {`{"date":"2020-04-10 00:00:00"}`, "2020-05-02 00:00:00", false},
+	}
+
+	for index, test := range tests {
+		var festival Festival
+		assert.Nil(json.Unmarshal([]byte(test.input), &festival), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, festival.Date.ToCarbon().ToDateTimeString(PRC), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.leap, festival.Date.IsLeapMonth(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestError_LunarJson(t *testing.T) {
+	assert := assert.New(t)
+
+	type Festival struct {
+		Date LunarDate `json:"date"`
+	}
+
+	_, marshalErr := json.Marshal(Festival{Date: Parse("2500-08-05").Lunar()})
+	assert.NotNil(marshalErr)
+
+	for index, input := range []string{"xxx", "2020-05L-01 00:00:00", "2020-13-01 00:00:00", "2020-1-1 00:00:00", "2500-01-01 00:00:00"} {
+		var festival Festival
+		err := json.Unmarshal([]byte(`{"date":"`+input+`"}`), &festival)
+		assert.NotNil(err, "Current test index is "+strconv.Itoa(index))
+	}
+}
@@ -1,41 +1,297 @@
 package carbon
 
 import (
+	"errors"
 	"fmt"
 )
 
+// sentinel errors which can be checked by errors.Is, the typed errors like *TimezoneError and all other errors wrap them.
+// 可通过 errors.Is 检查的哨兵错误，*TimezoneError 等类型错误及其他所有错误包装了它们
+var (
+	ErrInvalidTimezone     = errors.New("invalid timezone")             // 无效的时区
+	ErrInvalidDuration     = errors.New("invalid duration")             // 无效的时长
+	ErrInvalidValue        = errors.New("invalid value")                // 无效的时间字符串
+	ErrMismatchedLayout    = errors.New("mismatched layout")            // 不匹配的布局模板
+	ErrMismatchedFormat    = errors.New("mismatched format")            // 不匹配的格式模板
+	ErrInvalidLocale       = errors.New("invalid locale")               // 无效的区域
+	ErrInvalidTag          = errors.New("invalid tag")                  // 无效的标签
+	ErrLunarYearOutOfRange = errors.New("lunar year out of range")      // 超出范围的农历年份
+	ErrInvalidCalendar     = errors.New("invalid calendar")             // 无效的历法
+	ErrInvalidDate         = errors.New("invalid date")                 // 无效的历法日期
+	ErrInvalidHoliday      = errors.New("invalid holiday")              // 无效的节假日
+	ErrNoBusinessDay       = errors.New("cannot find any business day") // 找不到营业日
+	ErrInvalidRecurrence   = errors.New("invalid recurrence")           // 无效的重复规则
+	ErrInvalidCron         = errors.New("invalid cron expression")      // 无效的 cron 表达式
+	ErrInvalidICalendar    = errors.New("invalid iCalendar")            // 无效的 iCalendar
+)
+
 // returns an invalid timezone error.
 // 无效的时区错误
 var invalidTimezoneError = func(timezone string) error {
-	return fmt.Errorf("invalid timezone %q, please see the file %q for all valid timezones", timezone, "$GOROOT/lib/time/zoneinfo.zip")
+	return &TimezoneError{Timezone: timezone}
 }
 
 // returns an invalid location error.
 // 无效的地区错误
 var invalidLocationError = func() error {
-	return fmt.Errorf("invalid location, please make sure the location is valid")
+	return fmt.Errorf("%w location, please make sure the location is valid", ErrInvalidTimezone)
 }
 
 // returns an invalid duration error.
 // 无效的时长错误
 var invalidDurationError = func(duration string) error {
-	return fmt.Errorf("invalid duration %q, please make sure the duration is valid", duration)
+	return &DurationError{Duration: duration}
 }
 
 // returns an invalid value error.
 // 无效的时间字符串错误
 var invalidValueError = func(value string) error {
-	return fmt.Errorf("cannot parse string %q as carbon, please make sure the value is valid", value)
+	return &ValueError{Value: value}
 }
 
 // returns an invalid layout error.
 // 无效的布局模板错误
 var invalidLayoutError = func(value, layout string) error {
-	return fmt.Errorf("cannot parse string %q as carbon by layout %q, please make sure the value and layout match", value, layout)
+	return &LayoutError{Value: value, Layout: layout}
 }
 
 // returns an invalid format error.
 // 无效的格式模板错误
 var invalidFormatError = func(value, format string) error {
-	return fmt.Errorf("cannot parse string %q as carbon by format %q, please make sure the value and format match", value, format)
+	return &FormatError{Value: value, Format: format}
+}
+
+// returns an invalid step error.
+// 无效的步长错误
+var invalidStepError = func(step int) error {
+	return fmt.Errorf("%w step %d, please make sure the step is not zero", ErrInvalidDuration, step)
+}
+
+// returns an invalid interval error.
+// 无效的时间间隔错误
+var invalidIntervalError = func(interval string) error {
+	return fmt.Errorf("%w interval %q, please make sure the interval is valid", ErrInvalidDuration, interval)
+}
+
+// returns an invalid business day error.
+// 无效的营业日错误
+var invalidBusinessDayError = func() error {
+	return fmt.Errorf("%w within a year, please make sure the weekend days and holidays are valid", ErrNoBusinessDay)
+}
+
+// returns an invalid holiday rule error.
+// 无效的节假日规则错误
+var invalidHolidayRuleError = func(rule string) error {
+	return fmt.Errorf("%w rule %q, please make sure the rule is valid", ErrInvalidHoliday, rule)
+}
+
+// returns an invalid holiday region error.
+// 无效的节假日区域错误
+var invalidHolidayRegionError = func(region string) error {
+	return fmt.Errorf("%w region file %q, please make sure the json file exists and is valid", ErrInvalidHoliday, region)
+}
+
+// returns an invalid Chinese holiday error.
+// 无效的中国法定节假日错误
+var invalidChineseHolidayError = func(name, from, to string) error {
+	return fmt.Errorf("%w %q of China from %q to %q, please make sure the name and dates are valid", ErrInvalidHoliday, name, from, to)
+}
+
+// returns an invalid recurrence error.
+// 无效的重复规则错误
+var invalidRecurrenceError = func(recurrence string) error {
+	return fmt.Errorf("%w %q, please make sure the recurrence is valid", ErrInvalidRecurrence, recurrence)
+}
+
+// returns an invalid cron error.
+// 无效的 cron 表达式错误
+var invalidCronError = func(expression string) error {
+	return fmt.Errorf("%w %q, please make sure the expression is valid", ErrInvalidCron, expression)
+}
+
+// returns an invalid iCalendar error.
+// 无效的 iCalendar 错误
+var invalidICalendarError = func(value string) error {
+	return fmt.Errorf("%w content %q, please make sure the content is valid", ErrInvalidICalendar, value)
+}
+
+// TimezoneError is returned when a timezone is invalid, it wraps ErrInvalidTimezone.
+// 无效的时区错误，包装了 ErrInvalidTimezone
+type TimezoneError struct {
+	Timezone string // the invalid timezone
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *TimezoneError) Error() string {
+	return fmt.Sprintf("invalid timezone %q, please see the file %q for all valid timezones", e.Timezone, "$GOROOT/lib/time/zoneinfo.zip")
+}
+
+// Unwrap returns ErrInvalidTimezone.
+// 返回 ErrInvalidTimezone
+func (e *TimezoneError) Unwrap() error {
+	return ErrInvalidTimezone
+}
+
+// DurationError is returned when a duration is invalid, it wraps ErrInvalidDuration.
+// 无效的时长错误，包装了 ErrInvalidDuration
+type DurationError struct {
+	Duration string // the invalid duration
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *DurationError) Error() string {
+	return fmt.Sprintf("invalid duration %q, please make sure the duration is valid", e.Duration)
+}
+
+// Unwrap returns ErrInvalidDuration.
+// 返回 ErrInvalidDuration
+func (e *DurationError) Unwrap() error {
+	return ErrInvalidDuration
+}
+
+// ValueError is returned when a time string cannot be parsed, it wraps ErrInvalidValue.
+// 无效的时间字符串错误，包装了 ErrInvalidValue
+type ValueError struct {
+	Value string // the invalid time string
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *ValueError) Error() string {
+	return fmt.Sprintf("cannot parse string %q as carbon, please make sure the value is valid", e.Value)
+}
+
+// Unwrap returns ErrInvalidValue.
+// 返回 ErrInvalidValue
+func (e *ValueError) Unwrap() error {
+	return ErrInvalidValue
+}
+
+// LayoutError is returned when a time string and layout mismatch, it wraps ErrMismatchedLayout.
+// 时间字符串与布局模板不匹配错误，包装了 ErrMismatchedLayout
+type LayoutError struct {
+	Value  string // the time string
+	Layout string // the mismatched layout
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *LayoutError) Error() string {
+	return fmt.Sprintf("cannot parse string %q as carbon by layout %q, please make sure the value and layout match", e.Value, e.Layout)
+}
+
+// Unwrap returns ErrMismatchedLayout.
+// 返回 ErrMismatchedLayout
+func (e *LayoutError) Unwrap() error {
+	return ErrMismatchedLayout
+}
+
+// FormatError is returned when a time string and format mismatch, it wraps ErrMismatchedFormat.
+// 时间字符串与格式模板不匹配错误，包装了 ErrMismatchedFormat
+type FormatError struct {
+	Value  string // the time string
+	Format string // the mismatched format
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("cannot parse string %q as carbon by format %q, please make sure the value and format match", e.Value, e.Format)
+}
+
+// Unwrap returns ErrMismatchedFormat.
+// 返回 ErrMismatchedFormat
+func (e *FormatError) Unwrap() error {
+	return ErrMismatchedFormat
+}
+
+// LocaleError is returned when a locale is invalid, it wraps ErrInvalidLocale.
+// 无效的区域错误，包装了 ErrInvalidLocale
+type LocaleError struct {
+	Locale string // the invalid locale
+	File   string // the json file of the locale
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *LocaleError) Error() string {
+	return fmt.Sprintf("invalid locale file %q, please make sure the json file exists and is valid", e.File)
+}
+
+// Unwrap returns ErrInvalidLocale.
+// 返回 ErrInvalidLocale
+func (e *LocaleError) Unwrap() error {
+	return ErrInvalidLocale
+}
+
+// TagError is returned when a carbon tag of a struct field is invalid, it wraps ErrInvalidTag.
+// 无效的标签错误，包装了 ErrInvalidTag
+type TagError struct {
+	Field string // the name of the struct field
+	Tag   string // the invalid carbon tag
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *TagError) Error() string {
+	return fmt.Sprintf("invalid carbon tag %q of field %q, please make sure the tag is valid", e.Tag, e.Field)
+}
+
+// Unwrap returns ErrInvalidTag.
+// 返回 ErrInvalidTag
+func (e *TagError) Unwrap() error {
+	return ErrInvalidTag
+}
+
+// LunarYearError is returned when a year is out of the supported range of the lunar calendar, it wraps ErrLunarYearOutOfRange.
+// 超出农历支持范围的年份错误，包装了 ErrLunarYearOutOfRange
+type LunarYearError struct {
+	Year int // the year out of range
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *LunarYearError) Error() string {
+	return fmt.Sprintf("invalid year %d, currently only %d years from %d to %d are supported", e.Year, maxYear-minYear, minYear, maxYear)
+}
+
+// Unwrap returns ErrLunarYearOutOfRange.
+// 返回 ErrLunarYearOutOfRange
+func (e *LunarYearError) Unwrap() error {
+	return ErrLunarYearOutOfRange
+}
+
+// ParseError is returned in strict mode when a time string cannot be parsed, it reports where the mismatch happened,
+// it wraps ErrMismatchedLayout and is also ErrInvalidValue.
+// 严格模式下无法解析时间字符串时返回的错误，报告不匹配的位置，包装了 ErrMismatchedLayout 并且也是 ErrInvalidValue
+type ParseError struct {
+	Value    string // the time string to be parsed
+	Layout   string // the layout which was tried
+	Offset   int    // the byte offset in the value where the mismatch happened
+	Expected string // the expected element, such as "month", "\"-\"" or "end of value"
+	Message  string // the reason if it is not a mismatch, such as "month out of range" or "extra text"
+}
+
+// Error implements the error interface.
+// 实现 error 接口
+func (e *ParseError) Error() string {
+	message := fmt.Sprintf("cannot parse string %q as carbon by layout %q at offset %d, expected %s", e.Value, e.Layout, e.Offset, e.Expected)
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+	return message
+}
+
+// Unwrap returns ErrMismatchedLayout.
+// 返回 ErrMismatchedLayout
+func (e *ParseError) Unwrap() error {
+	return ErrMismatchedLayout
+}
+
+// Is reports whether the target is ErrInvalidValue.
+// 目标是否为 ErrInvalidValue
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidValue
 }
@@ -0,0 +1,108 @@
+package carbon
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Is(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRules(HolidayRule{Date: "xxx"})
+
+	tests := []struct {
+		err      error
+		sentinel error
+	}{
+		0:  {Now("xxx").Error, ErrInvalidTimezone},
+		1:  {Parse("2020-08-05", "xxx").Error, ErrInvalidTimezone},
+		2:  {SetTimezone("xxx").Error, ErrInvalidTimezone},
+		3:  {Now().AddDuration("xxx").Error, ErrInvalidDuration},
+		4:  {Parse("xxx").Error, ErrInvalidValue},
+		5:  {ParseByLayout("xxx", DateLayout).Error, ErrMismatchedLayout},
+		6:  {ParseByFormat("xxx", DateFormat).Error, ErrMismatchedFormat},
+		7:  {SetLocale("xxx").Error, ErrInvalidLocale},
+		8:  {Parse("1500-01-01").Lunar().Error, ErrLunarYearOutOfRange},
+		9:  {ParseStrict("xxx").Error, ErrInvalidValue},
+		10: {ParseByLayoutStrict("xxx", DateLayout).Error, ErrMismatchedLayout},
+		11: {Now().SetLocation(nil).Error, ErrInvalidTimezone},
+		12: {ParseInterval("xxx").Error, ErrInvalidDuration},
+		13: {NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).EveryDays(0).Error, ErrInvalidDuration},
+		14: {Parse("2020-08-05").SetWeekendDays(Sunday, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday).AddBusinessDays(1).Error, ErrNoBusinessDay},
+		15: {calendar.Error, ErrInvalidHoliday},
+		16: {Now().SetHolidayRegion("xxx").Error, ErrInvalidHoliday},
+		17: {RegisterChineseHolidays(2098, ChineseHolidaySchedule{Holidays: []ChineseHoliday{{Name: "", From: "2098-01-01", To: "2098-01-01"}}}), ErrInvalidHoliday},
+		18: {Now().Recurrence("xxx").Error, ErrInvalidRecurrence},
+		19: {ParseCron("xxx").Error, ErrInvalidCron},
+		20: {ParseICalendar("SUMMARY:xxx").Error, ErrInvalidICalendar},
+		21: {SetLunarCalendar("xxx").Error, ErrInvalidCalendar},
+		22: {SetLunarOffset(99999).Error, ErrInvalidCalendar},
+		23: {SetHijriCalendar("xxx").Error, ErrInvalidCalendar},
+		24: {CreateFromLunar(2020, 13, 1, 0, 0, 0, false).Error, ErrInvalidDate},
+		25: {CreateFromLunar(2020, 5, 1, 0, 0, 0, true).Error, ErrInvalidDate},
+		26: {NewLunarAnniversary(13, 1, false).Error, ErrInvalidDate},
+		27: {CreateFromPersian(1399, 13, 1, 0, 0, 0).Error, ErrInvalidDate},
+		28: {CreateFromHijri(1441, 13, 1, 0, 0, 0).Error, ErrInvalidDate},
+	}
+
+	for index, test := range tests {
+		assert.True(errors.Is(test.err, test.sentinel), "Current test index is "+strconv.Itoa(index))
+	}
+
+	type Student struct {
+		Birthday Carbon `carbon:"xxx"`
+	}
+	assert.True(errors.Is(LoadTag(&Student{}), ErrInvalidTag))
+	assert.True(errors.Is(LoadTag(Student{}), ErrInvalidTag))
+}
+
+func TestError_As(t *testing.T) {
+	assert := assert.New(t)
+
+	var timezoneError *TimezoneError
+	assert.True(errors.As(Parse("2020-08-05", "xxx").Error, &timezoneError))
+	assert.Equal("xxx", timezoneError.Timezone)
+
+	var durationError *DurationError
+	assert.True(errors.As(Now().SubDuration("2x").Error, &durationError))
+	assert.Equal("2x", durationError.Duration)
+
+	var valueError *ValueError
+	assert.True(errors.As(Parse("xxx").Error, &valueError))
+	assert.Equal("xxx", valueError.Value)
+
+	var layoutError *LayoutError
+	assert.True(errors.As(ParseByLayout("xxx", DateLayout).Error, &layoutError))
+	assert.Equal("xxx", layoutError.Value)
+	assert.Equal(DateLayout, layoutError.Layout)
+
+	var formatError *FormatError
+	assert.True(errors.As(ParseByFormat("xxx", DateFormat).Error, &formatError))
+	assert.Equal("xxx", formatError.Value)
+	assert.Equal(DateFormat, formatError.Format)
+
+	var localeError *LocaleError
+	assert.True(errors.As(SetLocale("xxx").Error, &localeError))
+	assert.Equal("xxx", localeError.Locale)
+	assert.Equal("lang/xxx.json", localeError.File)
+
+	var tagError *TagError
+	type Student struct {
+		Birthday Carbon `carbon:"xxx"`
+	}
+	assert.True(errors.As(LoadTag(&Student{}), &tagError))
+	assert.Equal("Birthday", tagError.Field)
+	assert.Equal("xxx", tagError.Tag)
+
+	var lunarYearError *LunarYearError
+	assert.True(errors.As(Parse("2500-01-01").Lunar().Error, &lunarYearError))
+	assert.Equal(2500, lunarYearError.Year)
+	assert.Equal("invalid year 2500, currently only 800 years from 1600 to 2400 are supported", lunarYearError.Error())
+
+	// the timezone error is kept instead of being replaced by the format error
+	assert.True(errors.As(ParseByFormat("2020-08-05", DateFormat, "xxx").Error, &timezoneError))
+}
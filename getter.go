@@ -410,3 +410,18 @@ func (c Carbon) Age() int {
 	}
 	return int(c.DiffInYears(now))
 }
+
+// ExactAge gets age in detail like 18 years 2 months 3 days without overflowing month.
+// 获取详细年龄(月份不溢出)
+func (c Carbon) ExactAge() Interval {
+	i := Interval{lang: c.lang}
+	if c.IsInvalid() {
+		i.Error = c.Error
+		return i
+	}
+	now := c.Now()
+	if c.TimestampNano() > now.TimestampNano() {
+		return i
+	}
+	return c.DiffNoOverflow(now)
+}
@@ -295,3 +295,10 @@ func BenchmarkCarbon_Age(b *testing.B) {
 		now.Age()
 	}
 }
+
+func BenchmarkCarbon_ExactAge(b *testing.B) {
+	c := Parse("2000-02-29")
+	for n := 0; n < b.N; n++ {
+		c.ExactAge()
+	}
+}
@@ -1132,3 +1132,28 @@ func TestCarbon_Age(t *testing.T) {
 		assert.Equal(test.expected, c.Age(), "Current test index is "+strconv.Itoa(index))
 	}
 }
+
+func TestCarbon_ExactAge(t *testing.T) {
+	assert := assert.New(t)
+
+	var c Carbon
+	c.SetTestNow(Parse("2020-08-18 12:00:00"))
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"", "PT0S"},
+		1: {"2030-08-05", "PT0S"},
+		2: {"2002-06-15 08:00:00", "P18Y2M3DT4H"},
+		3: {"2000-02-29", "P20Y5M20DT12H"},
+	}
+
+	for index, test := range tests {
+		i := c.Parse(test.input).ExactAge()
+		assert.Nil(i.Error)
+		assert.Equal(test.expected, i.ToIso8601String(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.NotNil(Parse("xxx").ExactAge().Error, "It should catch an exception in ExactAge()")
+}
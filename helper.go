@@ -2,6 +2,8 @@ package carbon
 
 import (
 	"bytes"
+	"container/list"
+	"sync"
 	"time"
 )
 
@@ -66,6 +68,41 @@ var layouts = []string{
 	"20060102150405Z07:00", "20060102150405.999999999Z07:00",
 }
 
+// max number of the cached layout shapes, the least recently used one is evicted if it is exceeded
+// 最大缓存布局形状数，超出时淘汰最近最少使用的形状
+const maxLayoutShapes = 1024
+
+var (
+	// indexes of all layouts
+	// 所有布局模板的索引
+	allLayoutIndexes = func() []int {
+		indexes := make([]int, len(layouts))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		return indexes
+	}()
+
+	// candidate layout indexes which are keyed by the value shape, the recently used shapes are at the front of the list
+	// 按值形状存储的候选布局模板索引，最近使用的形状位于链表前端
+	layoutShapes = struct {
+		candidates map[string]*list.Element
+		recent     *list.List
+		mu         *sync.Mutex
+	}{
+		candidates: make(map[string]*list.Element),
+		recent:     list.New(),
+		mu:         new(sync.Mutex),
+	}
+)
+
+// cached candidate layout indexes of a value shape
+// 缓存的值形状候选布局模板索引
+type layoutShape struct {
+	shape   string
+	indexes []int
+}
+
 // converts format to layout.
 // format 转 layout
 func format2layout(format string) string {
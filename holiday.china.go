@@ -0,0 +1,198 @@
+package carbon
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// default directory of Chinese statutory holidays
+// 中国法定节假日默认目录
+var defaultChineseHolidayDir = defaultHolidayDir + "china/"
+
+// Chinese statutory holidays and make-up workdays which are keyed by year
+// 按年份存储的中国法定节假日和调休工作日
+var chineseHolidays = struct {
+	years map[int]chineseHolidayYear
+	rw    *sync.RWMutex
+}{
+	years: make(map[int]chineseHolidayYear),
+	rw:    new(sync.RWMutex),
+}
+
+// ChineseHoliday defines a ChineseHoliday struct, From and To are both included and formatted like "2024-02-10".
+// 定义 ChineseHoliday 结构体，From 和 To 均包含在内，格式如 "2024-02-10"
+type ChineseHoliday struct {
+	Name string `json:"name"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ChineseHolidaySchedule defines a ChineseHolidaySchedule struct, it is the holiday schedule of a year announced by the State Council.
+// Workdays are the weekend days which become working days(调休), formatted like "2024-02-04".
+// 定义 ChineseHolidaySchedule 结构体，即国务院公布的某年节假日安排
+type ChineseHolidaySchedule struct {
+	Holidays []ChineseHoliday `json:"holidays"`
+	Workdays []string         `json:"workdays"`
+}
+
+// ChineseHolidayCalendar defines a ChineseHolidayCalendar struct, it implements the HolidayProvider, HolidayNamer and MakeUpWorkdayProvider interfaces.
+// 定义 ChineseHolidayCalendar 结构体，实现了 HolidayProvider、HolidayNamer 和 MakeUpWorkdayProvider 接口
+type ChineseHolidayCalendar struct{}
+
+// parsed holiday schedule of a year, the dates are keyed like 20240210
+// 解析后的某年节假日安排，日期键名如 20240210
+type chineseHolidayYear struct {
+	holidays map[int]string
+	workdays map[int]bool
+}
+
+// NewChineseHolidayCalendar returns a new ChineseHolidayCalendar instance.
+// 初始化 ChineseHolidayCalendar 结构体
+func NewChineseHolidayCalendar() *ChineseHolidayCalendar {
+	return &ChineseHolidayCalendar{}
+}
+
+// IsHoliday reports whether is a Chinese statutory holiday, it implements the interface HolidayProvider.
+// 是否是中国法定节假日，实现 HolidayProvider 接口
+func (cc *ChineseHolidayCalendar) IsHoliday(c Carbon) bool {
+	return c.IsChineseHoliday()
+}
+
+// HolidayName gets the Chinese statutory holiday name, it implements the interface HolidayNamer.
+// 获取中国法定节假日名称，实现 HolidayNamer 接口
+func (cc *ChineseHolidayCalendar) HolidayName(c Carbon) string {
+	return c.ChineseHolidayName()
+}
+
+// IsMakeUpWorkday reports whether is a Chinese make-up workday(调休), it implements the interface MakeUpWorkdayProvider.
+// 是否是中国调休工作日，实现 MakeUpWorkdayProvider 接口
+func (cc *ChineseHolidayCalendar) IsMakeUpWorkday(c Carbon) bool {
+	return c.IsChineseMakeUpWorkday()
+}
+
+// RegisterChineseHolidays registers or replaces the holiday schedule of the year, such as a newly announced year.
+// 注册或替换某年的节假日安排，如新公布的年份
+func RegisterChineseHolidays(year int, schedule ChineseHolidaySchedule) error {
+	parsed, err := parseChineseHolidaySchedule(schedule)
+	if err != nil {
+		return err
+	}
+	chineseHolidays.rw.Lock()
+	defer chineseHolidays.rw.Unlock()
+	chineseHolidays.years[year] = parsed
+	return nil
+}
+
+// IsChineseHoliday reports whether is a Chinese statutory holiday.
+// 是否是中国法定节假日
+func (c Carbon) IsChineseHoliday() bool {
+	return c.ChineseHolidayName() != ""
+}
+
+// IsChineseWorkday reports whether is a Chinese workday, make-up workdays(调休) are workdays even if they are weekend days.
+// 是否是中国工作日，调休的周末也是工作日
+func (c Carbon) IsChineseWorkday() bool {
+	if c.IsInvalid() {
+		return false
+	}
+	if c.ChineseHolidayName() != "" {
+		return false
+	}
+	if c.IsChineseMakeUpWorkday() {
+		return true
+	}
+	weekday := c.ToStdTime().Weekday()
+	return weekday != time.Saturday && weekday != time.Sunday
+}
+
+// IsChineseMakeUpWorkday reports whether is a Chinese make-up workday(调休) announced in the holiday schedule.
+// 是否是节假日安排中公布的中国调休工作日
+func (c Carbon) IsChineseMakeUpWorkday() bool {
+	if c.IsInvalid() {
+		return false
+	}
+	key := getDateKey(c.ToStdTime())
+	// the make-up workday may be announced in the schedule of the next year
+	for year := key / 10000; year <= key/10000+1; year++ {
+		if getChineseHolidayYear(year).workdays[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// ChineseHolidayName gets the Chinese statutory holiday name like "春节".
+// 获取中国法定节假日名称，如 "春节"
+func (c Carbon) ChineseHolidayName() string {
+	if c.IsInvalid() {
+		return ""
+	}
+	key := getDateKey(c.ToStdTime())
+	// the holiday may start in the previous year, such as 2022-12-31 of the New Year's Day in 2023
+	for year := key / 10000; year <= key/10000+1; year++ {
+		if name, ok := getChineseHolidayYear(year).holidays[key]; ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// gets the holiday schedule of the year, it loads the embedded json file for the first time.
+// 获取某年节假日安排，首次获取时加载内嵌的 json 文件
+func getChineseHolidayYear(year int) chineseHolidayYear {
+	chineseHolidays.rw.RLock()
+	parsed, ok := chineseHolidays.years[year]
+	chineseHolidays.rw.RUnlock()
+	if ok {
+		return parsed
+	}
+
+	var schedule ChineseHolidaySchedule
+	if bytes, err := holidayFs.ReadFile(defaultChineseHolidayDir + strconv.Itoa(year) + ".json"); err == nil {
+		_ = json.Unmarshal(bytes, &schedule)
+	}
+	parsed, _ = parseChineseHolidaySchedule(schedule)
+
+	chineseHolidays.rw.Lock()
+	defer chineseHolidays.rw.Unlock()
+	if registered, ok := chineseHolidays.years[year]; ok {
+		return registered
+	}
+	chineseHolidays.years[year] = parsed
+	return parsed
+}
+
+// parses the holiday schedule.
+// 解析节假日安排
+func parseChineseHolidaySchedule(schedule ChineseHolidaySchedule) (chineseHolidayYear, error) {
+	parsed := chineseHolidayYear{
+		holidays: make(map[int]string),
+		workdays: make(map[int]bool),
+	}
+	for _, holiday := range schedule.Holidays {
+		from, err1 := time.Parse(DateLayout, holiday.From)
+		to, err2 := time.Parse(DateLayout, holiday.To)
+		if err1 != nil || err2 != nil || from.After(to) || holiday.Name == "" {
+			return parsed, invalidChineseHolidayError(holiday.Name, holiday.From, holiday.To)
+		}
+		for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+			parsed.holidays[getDateKey(date)] = holiday.Name
+		}
+	}
+	for _, workday := range schedule.Workdays {
+		date, err := time.Parse(DateLayout, workday)
+		if err != nil {
+			return parsed, invalidChineseHolidayError("", workday, workday)
+		}
+		parsed.workdays[getDateKey(date)] = true
+	}
+	return parsed, nil
+}
+
+// gets the date key of the time like 20240210.
+// 获取日期键名，如 20240210
+func getDateKey(t time.Time) int {
+	return t.Year()*10000 + int(t.Month())*100 + t.Day()
+}
@@ -0,0 +1,41 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_IsChineseHoliday(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.IsChineseHoliday()
+	}
+}
+
+func BenchmarkCarbon_IsChineseWorkday(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.IsChineseWorkday()
+	}
+}
+
+func BenchmarkCarbon_IsChineseMakeUpWorkday(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.IsChineseMakeUpWorkday()
+	}
+}
+
+func BenchmarkCarbon_ChineseHolidayName(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.ChineseHolidayName()
+	}
+}
+
+func BenchmarkRegisterChineseHolidays(b *testing.B) {
+	schedule := ChineseHolidaySchedule{
+		Holidays: []ChineseHoliday{{Name: "元旦", From: "2099-01-01", To: "2099-01-03"}},
+		Workdays: []string{"2099-01-04"},
+	}
+	for n := 0; n < b.N; n++ {
+		_ = RegisterChineseHolidays(2099, schedule)
+	}
+}
@@ -0,0 +1,180 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarbon_IsChineseHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		0:  {"", false},
+		1:  {"2020-08-05", false},
+		2:  {"2020-10-08", true},
+		3:  {"2021-02-11", true},
+		4:  {"2021-02-07", false},
+		5:  {"2022-12-31", true},
+		6:  {"2023-01-02", true},
+		7:  {"2024-02-17", true},
+		8:  {"2024-02-18", false},
+		9:  {"2024-06-10", true},
+		10: {"2025-10-08", true},
+		11: {"2019-10-01", false},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.IsChineseHoliday(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_IsChineseWorkday(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		0:  {"", false},
+		1:  {"2020-08-05", true},
+		2:  {"2020-08-08", false},
+		3:  {"2020-10-10", true},
+		4:  {"2021-10-01", false},
+		5:  {"2022-01-29", true},
+		6:  {"2022-01-30", true},
+		7:  {"2023-06-25", true},
+		8:  {"2024-02-04", true},
+		9:  {"2024-02-12", false},
+		10: {"2025-02-08", true},
+		11: {"2025-02-09", false},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.IsChineseWorkday(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_IsChineseMakeUpWorkday(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		0: {"", false},
+		1: {"2020-08-05", false},
+		2: {"2020-10-10", true},
+		3: {"2021-10-01", false},
+		4: {"2024-02-04", true},
+		5: {"2024-05-11", true},
+		6: {"2024-05-12", false},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.IsChineseMakeUpWorkday(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_ChineseHolidayName(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"", ""},
+		1: {"2020-08-05", ""},
+		2: {"2021-01-01", "元旦"},
+		3: {"2022-12-31", "元旦"},
+		4: {"2023-10-01", "中秋节、国庆节"},
+		5: {"2024-02-10", "春节"},
+		6: {"2024-04-05", "清明节"},
+		7: {"2024-05-01", "劳动节"},
+		8: {"2025-05-31", "端午节"},
+		9: {"2024-09-16", "中秋节"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ChineseHolidayName(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestRegisterChineseHolidays(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Nil(RegisterChineseHolidays(2099, ChineseHolidaySchedule{
+		Holidays: []ChineseHoliday{
+			{Name: "元旦", From: "2098-12-31", To: "2099-01-02"},
+			{Name: "春节", From: "2099-01-20", To: "2099-01-26"},
+		},
+		Workdays: []string{"2099-01-17", "2099-01-31"},
+	}))
+
+	assert.True(Parse("2098-12-31").IsChineseHoliday())
+	assert.Equal("春节", Parse("2099-01-22").ChineseHolidayName())
+	assert.True(Parse("2099-01-17").IsChineseWorkday())
+	assert.False(Parse("2099-01-18").IsChineseWorkday())
+	assert.True(Parse("2099-01-27").IsChineseWorkday())
+
+	assert.Nil(RegisterChineseHolidays(2099, ChineseHolidaySchedule{}))
+	assert.False(Parse("2099-01-22").IsChineseHoliday())
+}
+
+func TestChineseHolidayCalendar(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewChineseHolidayCalendar()
+	tests := []struct {
+		input         string
+		isHoliday     bool
+		name          string
+		isBusinessDay bool
+		nextBusiness  string
+	}{
+		0: {"2024-02-04", false, "", true, "2024-02-05"},
+		1: {"2024-02-09", false, "", true, "2024-02-18"},
+		2: {"2024-02-10", true, "春节", false, "2024-02-18"},
+		3: {"2024-09-27", false, "", true, "2024-09-29"},
+		4: {"2024-09-30", false, "", true, "2024-10-08"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).SetHolidayProvider(calendar)
+		assert.Nil(c.Error)
+		assert.Equal(test.isHoliday, c.IsHoliday(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.name, c.Holiday(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.isBusinessDay, c.IsBusinessDay(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.nextBusiness, c.NextBusinessDay().ToDateString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	// the configured weekend days are kept except for the make-up workdays
+	assert.False(Parse("2024-08-09").SetHolidayProvider(calendar).SetWeekendDays(Friday, Saturday).IsBusinessDay())
+	assert.True(Parse("2024-08-11").SetHolidayProvider(calendar).SetWeekendDays(Friday, Saturday).IsBusinessDay())
+	assert.True(Parse("2024-05-11").SetHolidayProvider(calendar).SetWeekendDays(Friday, Saturday).IsBusinessDay())
+	assert.False(Parse("2024-05-12").SetHolidayProvider(calendar).IsBusinessDay())
+}
+
+func TestError_ChineseHoliday(t *testing.T) {
+	schedules := []ChineseHolidaySchedule{
+		{Holidays: []ChineseHoliday{{Name: "", From: "2099-01-01", To: "2099-01-01"}}},
+		{Holidays: []ChineseHoliday{{Name: "元旦", From: "xxx", To: "2099-01-01"}}},
+		{Holidays: []ChineseHoliday{{Name: "元旦", From: "2099-01-03", To: "2099-01-01"}}},
+		{Workdays: []string{"xxx"}},
+	}
+	for index, schedule := range schedules {
+		assert.NotNil(t, RegisterChineseHolidays(2098, schedule), "It should catch an exception in RegisterChineseHolidays(), current test index is "+strconv.Itoa(index))
+	}
+}
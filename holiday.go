@@ -0,0 +1,464 @@
+package carbon
+
+import (
+	"embed"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed holiday
+var holidayFs embed.FS
+
+// default holiday directory
+// 默认节假日目录
+var defaultHolidayDir = "holiday/"
+
+// the rules of all embedded holiday regions which are keyed by the file names, they are loaded only once
+// 按文件名存储的所有内嵌节假日区域的规则，只加载一次
+var holidayRegions = struct {
+	once  sync.Once
+	rules map[string][]HolidayRule
+}{}
+
+// holiday rule kinds
+// 节假日规则类型
+const (
+	fixedHolidayRule   = iota // like "12-25"
+	weekdayHolidayRule        // like "last monday of may"
+	easterHolidayRule         // like "easter+1" or "orthodox_easter-2"
+)
+
+// observed date shifting modes
+// 调休方式
+const (
+	nearestObserved    = "nearest"    // Saturday to Friday and Sunday to Monday
+	mondayObserved     = "monday"     // Saturday and Sunday to the following Monday
+	substituteObserved = "substitute" // to the next weekday which is not a holiday
+)
+
+var (
+	holidayOrdinals = map[string]int{
+		"first": 1, "1st": 1,
+		"second": 2, "2nd": 2,
+		"third": 3, "3rd": 3,
+		"fourth": 4, "4th": 4,
+		"fifth": 5, "5th": 5,
+		"last": -1,
+	}
+	holidayWeekdays = make(map[string]time.Weekday, DaysPerWeek)
+	holidayMonths   = make(map[string]int, MonthsPerYear)
+)
+
+func init() {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		holidayWeekdays[strings.ToLower(day.String())] = day
+	}
+	for month := time.January; month <= time.December; month++ {
+		holidayMonths[strings.ToLower(month.String())] = int(month)
+	}
+}
+
+// HolidayNamer defines a HolidayNamer interface, it is an optional interface of HolidayProvider to get the holiday name.
+// 定义 HolidayNamer 接口，HolidayProvider 的可选接口，用于获取节假日名称
+type HolidayNamer interface {
+	HolidayName(c Carbon) string
+}
+
+// HolidayRule defines a HolidayRule struct.
+// Date supports a fixed date like "12-25", a nth weekday like "last monday of may" or "3rd monday of january"
+// and an offset of Easter like "easter+1" or "orthodox_easter-2".
+// Observed supports "nearest", "monday" and "substitute", it shifts the holiday when it falls on Saturday or Sunday.
+// From and To limit the years in which the rule is valid, zero means unlimited.
+// Name is the default name, the localized one is looked up by Key in the "holidays" resource of the language.
+// 定义 HolidayRule 结构体
+type HolidayRule struct {
+	Key      string `json:"key"`
+	Date     string `json:"date"`
+	Observed string `json:"observed,omitempty"`
+	From     int    `json:"from,omitempty"`
+	To       int    `json:"to,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// Holiday defines a Holiday struct.
+// 定义 Holiday 结构体
+type Holiday struct {
+	Key      string
+	Name     string
+	Date     Carbon
+	Observed bool
+}
+
+// HolidayCalendar defines a HolidayCalendar struct, it implements the HolidayProvider and HolidayNamer interfaces.
+// 定义 HolidayCalendar 结构体，实现了 HolidayProvider 和 HolidayNamer 接口
+type HolidayCalendar struct {
+	dir    string
+	region string
+	rules  []holidayRule
+	cache  map[int][]holiday
+	Error  error
+	rw     *sync.RWMutex
+}
+
+// parsed holiday rule
+// 解析后的节假日规则
+type holidayRule struct {
+	HolidayRule
+	kind       int
+	month, day int
+	nth        int
+	weekday    time.Weekday
+	offset     int
+	isOrthodox bool
+}
+
+// a holiday in a year, the rule is never changed after it is parsed
+// 某年的一个节假日，规则解析后不会再改变
+type holiday struct {
+	rule     *holidayRule
+	date     time.Time
+	observed bool
+}
+
+// NewHolidayCalendar returns a new HolidayCalendar instance.
+// 初始化 HolidayCalendar 结构体
+func NewHolidayCalendar() *HolidayCalendar {
+	return &HolidayCalendar{
+		dir:   defaultHolidayDir,
+		cache: make(map[int][]holiday),
+		rw:    new(sync.RWMutex),
+	}
+}
+
+// SetRegion loads the built-in holiday rules of the region like "us" or "gb".
+// 加载区域内置的节假日规则
+func (hc *HolidayCalendar) SetRegion(region string) {
+	fileName := hc.dir + region + ".json"
+	holidayRegions.once.Do(loadHolidayRegions)
+	rules, ok := holidayRegions.rules[fileName]
+	if !ok {
+		hc.Error = invalidHolidayRegionError(fileName)
+		return
+	}
+	hc.rw.Lock()
+	hc.region, hc.rules = region, nil
+	hc.rw.Unlock()
+	hc.SetRules(rules...)
+}
+
+// SetRules appends some holiday rules.
+// 追加节假日规则
+func (hc *HolidayCalendar) SetRules(rules ...HolidayRule) {
+	hc.rw.Lock()
+	defer hc.rw.Unlock()
+
+	for _, rule := range rules {
+		r, err := parseHolidayRule(rule)
+		if err != nil {
+			hc.Error = err
+			continue
+		}
+		hc.rules = append(hc.rules, r)
+	}
+	hc.cache = make(map[int][]holiday)
+}
+
+// Region gets the region like "us".
+// 获取区域
+func (hc *HolidayCalendar) Region() string {
+	hc.rw.RLock()
+	defer hc.rw.RUnlock()
+	return hc.region
+}
+
+// IsHoliday reports whether is a holiday, it implements the interface HolidayProvider.
+// 是否是节假日，实现 HolidayProvider 接口
+func (hc *HolidayCalendar) IsHoliday(c Carbon) bool {
+	if c.IsInvalid() {
+		return false
+	}
+	return len(hc.find(c)) > 0
+}
+
+// HolidayName gets the holiday name in the language of the given Carbon instance, it implements the interface HolidayNamer.
+// 获取节假日名称，实现 HolidayNamer 接口
+func (hc *HolidayCalendar) HolidayName(c Carbon) string {
+	if c.IsInvalid() {
+		return ""
+	}
+	holidays := hc.find(c)
+	if len(holidays) == 0 {
+		return ""
+	}
+	return holidays[0].rule.name(c.lang)
+}
+
+// HolidaysBetween gets all holidays between the start and end day, both days are included.
+// 获取开始日期和结束日期之间的所有节假日(包含两端)
+func (hc *HolidayCalendar) HolidaysBetween(start, end Carbon) []Holiday {
+	holidays := make([]Holiday, 0)
+	if start.IsInvalid() || end.IsInvalid() {
+		return holidays
+	}
+	from, to := toHolidayDate(start), toHolidayDate(end.SetLocation(start.loc))
+	for year := from.Year() - 1; year <= to.Year()+1; year++ {
+		for _, h := range hc.inYear(year) {
+			if h.date.Before(from) || h.date.After(to) {
+				continue
+			}
+			date := start.create(h.date.Year(), int(h.date.Month()), h.date.Day(), 0, 0, 0, 0)
+			holidays = append(holidays, Holiday{
+				Key:      h.rule.Key,
+				Name:     h.rule.name(start.lang),
+				Date:     date,
+				Observed: h.observed,
+			})
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Lt(holidays[j].Date)
+	})
+	return holidays
+}
+
+// IsHoliday reports whether is a holiday by the holiday provider.
+// 是否是节假日
+func (c Carbon) IsHoliday() bool {
+	if c.IsInvalid() || c.holidays == nil {
+		return false
+	}
+	return c.holidays.IsHoliday(c)
+}
+
+// Holiday gets the holiday name like "Christmas Day", i18n is supported if the holiday provider implements the HolidayNamer interface.
+// 获取节假日名称，如果节假日提供者实现了 HolidayNamer 接口则支持i18n
+func (c Carbon) Holiday() string {
+	if !c.IsHoliday() {
+		return ""
+	}
+	if namer, ok := c.holidays.(HolidayNamer); ok {
+		return namer.HolidayName(c)
+	}
+	return ""
+}
+
+// finds the holidays of the given day, including the observed holidays which are shifted from the adjacent years.
+// 查找给定日期的节假日，包括从相邻年份调休过来的节假日
+func (hc *HolidayCalendar) find(c Carbon) []holiday {
+	date := toHolidayDate(c)
+	holidays := make([]holiday, 0)
+	for year := date.Year() - 1; year <= date.Year()+1; year++ {
+		for _, h := range hc.inYear(year) {
+			if h.date.Equal(date) {
+				holidays = append(holidays, h)
+			}
+		}
+	}
+	return holidays
+}
+
+// gets the localized name of the rule from the "holidays" resource of the language, it falls back to the name and then the key.
+// 从语言的 "holidays" 资源获取规则的本地化名称，依次回退到名称和键名
+func (r *holidayRule) name(lang *Language) string {
+	if name, ok := lang.getKeyedResource("holidays", r.Key); ok {
+		return name
+	}
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.Key
+}
+
+// gets all holidays in the year, the result is cached.
+// 获取某年所有节假日，结果会被缓存
+func (hc *HolidayCalendar) inYear(year int) []holiday {
+	hc.rw.RLock()
+	holidays, ok := hc.cache[year]
+	hc.rw.RUnlock()
+	if ok {
+		return holidays
+	}
+
+	hc.rw.Lock()
+	defer hc.rw.Unlock()
+
+	// the year may be cached by another caller while waiting for the lock
+	if holidays, ok = hc.cache[year]; ok {
+		return holidays
+	}
+	taken := make(map[time.Time]bool)
+	for index := range hc.rules {
+		rule := &hc.rules[index]
+		if (rule.From > 0 && year < rule.From) || (rule.To > 0 && year > rule.To) {
+			continue
+		}
+		if date, ok := rule.dateInYear(year); ok {
+			holidays = append(holidays, holiday{rule: rule, date: date})
+			taken[date] = true
+		}
+	}
+	for _, h := range holidays {
+		if date, ok := h.rule.observedDate(h.date, taken); ok {
+			holidays = append(holidays, holiday{rule: h.rule, date: date, observed: true})
+			taken[date] = true
+		}
+	}
+	hc.cache[year] = holidays
+	return holidays
+}
+
+// loads the rules of all embedded holiday regions.
+// 加载所有内嵌节假日区域的规则
+func loadHolidayRegions() {
+	holidayRegions.rules = make(map[string][]HolidayRule)
+	entries, _ := holidayFs.ReadDir(strings.TrimSuffix(defaultHolidayDir, "/"))
+	for _, entry := range entries {
+		var rules []HolidayRule
+		fileName := defaultHolidayDir + entry.Name()
+		if bytes, err := holidayFs.ReadFile(fileName); err == nil && json.Unmarshal(bytes, &rules) == nil {
+			holidayRegions.rules[fileName] = rules
+		}
+	}
+}
+
+// gets the date of the rule in the year.
+// 获取规则在某年的日期
+func (r holidayRule) dateInYear(year int) (time.Time, bool) {
+	switch r.kind {
+	case weekdayHolidayRule:
+		if r.nth < 0 {
+			last := time.Date(year, time.Month(r.month)+1, 0, 0, 0, 0, 0, time.UTC)
+			return last.AddDate(0, 0, -(int(last.Weekday())-int(r.weekday)+DaysPerWeek)%DaysPerWeek), true
+		}
+		first := time.Date(year, time.Month(r.month), 1, 0, 0, 0, 0, time.UTC)
+		date := first.AddDate(0, 0, (int(r.weekday)-int(first.Weekday())+DaysPerWeek)%DaysPerWeek+(r.nth-1)*DaysPerWeek)
+		return date, date.Month() == first.Month()
+	case easterHolidayRule:
+		return getEaster(year, r.isOrthodox).AddDate(0, 0, r.offset), true
+	}
+	date := time.Date(year, time.Month(r.month), r.day, 0, 0, 0, 0, time.UTC)
+	// Feb 29 doesn't exist in a common year
+	return date, date.Day() == r.day
+}
+
+// gets the observed date if the holiday falls on Saturday or Sunday.
+// 如果节假日是周六或周日，获取调休日期
+func (r holidayRule) observedDate(date time.Time, taken map[time.Time]bool) (time.Time, bool) {
+	weekday := date.Weekday()
+	if weekday != time.Saturday && weekday != time.Sunday {
+		return date, false
+	}
+	switch r.Observed {
+	case nearestObserved:
+		if weekday == time.Saturday {
+			return date.AddDate(0, 0, -1), true
+		}
+		return date.AddDate(0, 0, 1), true
+	case mondayObserved:
+		if weekday == time.Saturday {
+			return date.AddDate(0, 0, 2), true
+		}
+		return date.AddDate(0, 0, 1), true
+	case substituteObserved:
+		for {
+			date = date.AddDate(0, 0, 1)
+			if weekday = date.Weekday(); weekday != time.Saturday && weekday != time.Sunday && !taken[date] {
+				return date, true
+			}
+		}
+	}
+	return date, false
+}
+
+// parses a holiday rule.
+// 解析节假日规则
+func parseHolidayRule(rule HolidayRule) (holidayRule, error) {
+	r := holidayRule{HolidayRule: rule}
+	if r.Key == "" {
+		r.Key = rule.Date
+	}
+	switch r.Observed {
+	case "", nearestObserved, mondayObserved, substituteObserved:
+	default:
+		return r, invalidHolidayRuleError(rule.Date)
+	}
+	if r.To > 0 && r.From > r.To {
+		return r, invalidHolidayRuleError(rule.Date)
+	}
+
+	date := strings.ToLower(strings.TrimSpace(rule.Date))
+	if strings.HasPrefix(date, "easter") || strings.HasPrefix(date, "orthodox_easter") {
+		r.kind, r.isOrthodox = easterHolidayRule, strings.HasPrefix(date, "orthodox_")
+		offset := strings.TrimPrefix(strings.TrimPrefix(date, "orthodox_"), "easter")
+		if offset == "" {
+			return r, nil
+		}
+		if offset[0] != '+' && offset[0] != '-' {
+			return r, invalidHolidayRuleError(rule.Date)
+		}
+		days, err := strconv.Atoi(offset)
+		if err != nil {
+			return r, invalidHolidayRuleError(rule.Date)
+		}
+		r.offset = days
+		return r, nil
+	}
+
+	if fields := strings.Fields(date); len(fields) == 4 && fields[2] == "of" {
+		nth, ok1 := holidayOrdinals[fields[0]]
+		weekday, ok2 := holidayWeekdays[fields[1]]
+		month, ok3 := holidayMonths[fields[3]]
+		if !ok1 || !ok2 || !ok3 {
+			return r, invalidHolidayRuleError(rule.Date)
+		}
+		r.kind, r.nth, r.weekday, r.month = weekdayHolidayRule, nth, weekday, month
+		return r, nil
+	}
+
+	parts := strings.Split(date, "-")
+	if len(parts) != 2 {
+		return r, invalidHolidayRuleError(rule.Date)
+	}
+	month, err1 := strconv.Atoi(parts[0])
+	day, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || month < 1 || month > MonthsPerYear || day < 1 || day > time.Date(2000, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return r, invalidHolidayRuleError(rule.Date)
+	}
+	r.kind, r.month, r.day = fixedHolidayRule, month, day
+	return r, nil
+}
+
+// gets the Easter Sunday of the year in the Gregorian calendar, see https://en.wikipedia.org/wiki/Date_of_Easter.
+// 获取某年复活节(公历)
+func getEaster(year int, isOrthodox bool) time.Time {
+	if isOrthodox {
+		// Meeus's Julian algorithm, then converts the Julian date to the Gregorian date
+		a, b, c := year%4, year%7, year%19
+		d := (19*c + 15) % 30
+		e := (2*a + 4*b - d + 34) % 7
+		month, day := (d+e+114)/31, (d+e+114)%31+1
+		return time.Date(year, time.Month(month), day+year/100-year/400-2, 0, 0, 0, 0, time.UTC)
+	}
+	// anonymous Gregorian algorithm
+	a, b, c := year%19, year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month, day := (h+l-7*m+114)/31, (h+l-7*m+114)%31+1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// converts the Carbon instance to a date in UTC for comparing.
+// 转换为 UTC 日期用于比较
+func toHolidayDate(c Carbon) time.Time {
+	year, month, day := c.Date()
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,51 @@
+package carbon
+
+import "testing"
+
+func BenchmarkHolidayCalendar_SetRegion(b *testing.B) {
+	calendar := NewHolidayCalendar()
+	for n := 0; n < b.N; n++ {
+		calendar.SetRegion("us")
+	}
+}
+
+func BenchmarkHolidayCalendar_IsHoliday(b *testing.B) {
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("us")
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		calendar.IsHoliday(now)
+	}
+}
+
+func BenchmarkHolidayCalendar_HolidayName(b *testing.B) {
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("us")
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		calendar.HolidayName(now)
+	}
+}
+
+func BenchmarkHolidayCalendar_HolidaysBetween(b *testing.B) {
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("gb")
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		calendar.HolidaysBetween(now, now.AddYear())
+	}
+}
+
+func BenchmarkCarbon_IsHoliday(b *testing.B) {
+	now := Now().SetHolidayRegion("us")
+	for n := 0; n < b.N; n++ {
+		now.IsHoliday()
+	}
+}
+
+func BenchmarkCarbon_Holiday(b *testing.B) {
+	now := Now().SetHolidayRegion("us")
+	for n := 0; n < b.N; n++ {
+		now.Holiday()
+	}
+}
@@ -0,0 +1,269 @@
+package carbon
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHolidayCalendar_SetRegion(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("us")
+	assert.Nil(calendar.Error)
+	assert.Equal("us", calendar.Region())
+
+	calendar.SetRegion("gb")
+	assert.Nil(calendar.Error)
+	assert.Equal("gb", calendar.Region())
+	assert.False(calendar.IsHoliday(Parse("2020-07-04")))
+	assert.True(calendar.IsHoliday(Parse("2020-08-31")))
+}
+
+func TestHolidayCalendar_IsHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	us, gb := NewHolidayCalendar(), NewHolidayCalendar()
+	us.SetRegion("us")
+	gb.SetRegion("gb")
+
+	tests := []struct {
+		calendar *HolidayCalendar
+		input    string
+		expected bool
+	}{
+		0:  {us, "", false},
+		1:  {us, "2024-01-01", true},
+		2:  {us, "2024-01-15", true},
+		3:  {us, "2024-01-08", false},
+		4:  {us, "2024-05-27", true},
+		5:  {us, "2024-05-20", false},
+		6:  {us, "2024-11-28", true},
+		7:  {us, "2021-07-04", true},
+		8:  {us, "2021-07-05", true},
+		9:  {us, "2021-12-24", true},
+		10: {us, "2021-12-31", true},
+		11: {us, "2020-06-19", false},
+		12: {us, "2021-06-18", true},
+		13: {us, "1975-10-27", true},
+		14: {us, "1975-11-11", false},
+		15: {gb, "2024-03-29", true},
+		16: {gb, "2024-04-01", true},
+		17: {gb, "2020-05-08", true},
+		18: {gb, "2020-05-04", false},
+		19: {gb, "2022-05-30", false},
+		20: {gb, "2022-06-02", true},
+		21: {gb, "2022-06-03", true},
+		22: {gb, "2021-12-27", true},
+		23: {gb, "2021-12-28", true},
+		24: {gb, "2022-12-27", true},
+		25: {gb, "2022-12-28", false},
+		26: {gb, "2022-01-03", true},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, test.calendar.IsHoliday(c), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestHolidayCalendar_SetRules(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRules(
+		HolidayRule{Key: "orthodox_good_friday", Date: "orthodox_easter-2"},
+		HolidayRule{Key: "orthodox_easter", Date: "Orthodox_Easter"},
+		HolidayRule{Key: "easter", Date: "easter"},
+		HolidayRule{Key: "leap_day", Date: "02-29"},
+		HolidayRule{Key: "company_day", Date: "2nd friday of june", From: 2020, To: 2021},
+		HolidayRule{Key: "fifth_friday", Date: "fifth friday of january"},
+		HolidayRule{Date: "08-05", Observed: "monday"},
+	)
+	assert.Nil(calendar.Error)
+
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		0:  {"2024-05-03", true},
+		1:  {"2024-05-05", true},
+		2:  {"2024-03-31", true},
+		3:  {"2025-04-20", true},
+		4:  {"2023-04-16", true},
+		5:  {"2021-05-02", true},
+		6:  {"2000-04-23", true},
+		7:  {"2020-02-29", true},
+		8:  {"2021-03-01", false},
+		9:  {"2019-06-14", false},
+		10: {"2020-06-12", true},
+		11: {"2021-06-11", true},
+		12: {"2022-06-10", false},
+		13: {"2021-01-29", true},
+		14: {"2022-01-28", false},
+		15: {"2023-08-07", true},
+		16: {"2023-08-04", false},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.expected, calendar.IsHoliday(Parse(test.input)), "Current test index is "+strconv.Itoa(index))
+	}
+	assert.Equal("08-05", calendar.HolidayName(Parse("2020-08-05")))
+}
+
+func TestHolidayCalendar_HolidaysBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("gb")
+
+	holidays := calendar.HolidaysBetween(Parse("2021-12-20"), Parse("2022-01-05"))
+	assert.Len(holidays, 6)
+
+	expected := []struct {
+		key      string
+		name     string
+		date     string
+		observed bool
+	}{
+		0: {"christmas_day", "Christmas Day", "2021-12-25", false},
+		1: {"boxing_day", "Boxing Day", "2021-12-26", false},
+		2: {"christmas_day", "Christmas Day", "2021-12-27", true},
+		3: {"boxing_day", "Boxing Day", "2021-12-28", true},
+		4: {"new_years_day", "New Year's Day", "2022-01-01", false},
+		5: {"new_years_day", "New Year's Day", "2022-01-03", true},
+	}
+	for index, holiday := range holidays {
+		assert.Equal(expected[index].key, holiday.Key, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(expected[index].name, holiday.Name, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(expected[index].date, holiday.Date.ToDateString(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(expected[index].observed, holiday.Observed, "Current test index is "+strconv.Itoa(index))
+	}
+
+	holidays = calendar.HolidaysBetween(SetLocale("zh-CN").Parse("2024-03-29"), Parse("2024-04-01"))
+	assert.Len(holidays, 2)
+	assert.Equal("耶稣受难日", holidays[0].Name)
+	assert.Equal("复活节星期一", holidays[1].Name)
+
+	assert.Len(calendar.HolidaysBetween(Parse("2024-04-01"), Parse("2024-03-29")), 0)
+	assert.Len(calendar.HolidaysBetween(Parse(""), Parse("2024-03-29")), 0)
+}
+
+func TestHolidayCalendar_HolidayNameWithResources(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("gb")
+	calendar.SetRules(HolidayRule{Key: "company_day", Date: "08-05", Name: "Company Day"})
+
+	assert.Equal("Christmas Day", calendar.HolidayName(Parse("2020-12-25")))
+	assert.Equal("圣诞节", calendar.HolidayName(SetLocale("zh-CN").Parse("2020-12-25")))
+	assert.Equal("Early May bank holiday (VE day)", calendar.HolidayName(Parse("2020-05-08")))
+	assert.Equal("Company Day", calendar.HolidayName(SetLocale("zh-CN").Parse("2020-08-05")))
+
+	lang := NewLanguage()
+	lang.SetResources(map[string]string{"holidays": "christmas_day:Weihnachtstag|company_day:Firmentag"})
+	assert.Equal("Weihnachtstag", calendar.HolidayName(SetLanguage(lang).Parse("2020-12-25")))
+	assert.Equal("Firmentag", calendar.HolidayName(SetLanguage(lang).Parse("2020-08-05")))
+	assert.Equal("Boxing Day", calendar.HolidayName(SetLanguage(lang).Parse("2020-12-26")))
+}
+
+func TestHolidayCalendar_Concurrency(t *testing.T) {
+	assert := assert.New(t)
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion("gb")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				switch i % 4 {
+				case 0:
+					calendar.SetRules(HolidayRule{Key: "rule_" + strconv.Itoa(j), Date: "08-05"})
+				case 1:
+					calendar.SetRegion("gb")
+				default:
+					calendar.HolidaysBetween(Parse("2020-01-01"), Parse("2021-12-31"))
+					calendar.HolidayName(Parse("2020-12-25"))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	assert.Nil(calendar.Error)
+	assert.True(calendar.IsHoliday(Parse("2020-12-25")))
+}
+
+func TestCarbon_IsHoliday(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		expected bool
+	}{
+		0: {Parse(""), false},
+		1: {Parse("2020-12-25"), false},
+		2: {Parse("2020-12-25").SetHolidayRegion("us"), true},
+		3: {Parse("2020-12-24").SetHolidayRegion("us"), false},
+		4: {Parse("2020-10-01").SetHolidayProvider(testHolidays), true},
+	}
+
+	for index, test := range tests {
+		c := test.input
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.IsHoliday(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_Holiday(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Carbon
+		expected string
+	}{
+		0: {Parse("").SetHolidayRegion("us"), ""},
+		1: {Parse("2020-12-25"), ""},
+		2: {Parse("2020-12-25").SetHolidayRegion("us"), "Christmas Day"},
+		3: {Parse("2020-12-24").SetHolidayRegion("us"), ""},
+		4: {SetLocale("zh-CN").Parse("2020-12-25").SetHolidayRegion("us"), "圣诞节"},
+		5: {SetLocale("jp").Parse("2020-12-25").SetHolidayRegion("us"), "Christmas Day"},
+		6: {Parse("2020-10-01").SetHolidayProvider(testHolidays), ""},
+	}
+
+	for index, test := range tests {
+		c := test.input
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.Holiday(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestError_Holiday(t *testing.T) {
+	rules := []string{"", "xxx", "13-01", "02-30", "easter+", "easterx", "easter+x", "sixth monday of may", "first xxx of may", "first monday of xxx", "first monday in may"}
+	for _, rule := range rules {
+		calendar := NewHolidayCalendar()
+		calendar.SetRules(HolidayRule{Date: rule})
+		assert.NotNil(t, calendar.Error, "It should catch an exception in SetRules() with "+rule)
+	}
+
+	calendar := NewHolidayCalendar()
+	calendar.SetRules(HolidayRule{Date: "12-25", Observed: "xxx"})
+	assert.NotNil(t, calendar.Error, "It should catch an exception in SetRules()")
+
+	calendar = NewHolidayCalendar()
+	calendar.SetRules(HolidayRule{Date: "12-25", From: 2022, To: 2021})
+	assert.NotNil(t, calendar.Error, "It should catch an exception in SetRules()")
+
+	calendar = NewHolidayCalendar()
+	calendar.SetRegion("xxx")
+	assert.NotNil(t, calendar.Error, "It should catch an exception in SetRegion()")
+
+	assert.NotNil(t, Parse("2020-08-05").SetHolidayRegion("xxx").Error, "It should catch an exception in SetHolidayRegion()")
+}
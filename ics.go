@@ -0,0 +1,468 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// iCalendar component types
+// iCalendar 组件类型
+const (
+	ICalEvent = "VEVENT" // 事件
+	ICalTodo  = "VTODO"  // 待办
+)
+
+// default product identifier of iCalendar
+// 默认 iCalendar 产品标识
+const defaultICalProdID = "-//golang-module//carbon//EN"
+
+// max octets of an iCalendar content line, longer lines are folded
+// iCalendar 行最大字节数，更长的行会被折叠
+const maxICalLineOctets = 75
+
+var (
+	// iCalendar text escaper and unescaper
+	// iCalendar 文本转义器和反转义器
+	icalTextEscaper   = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	icalTextUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+)
+
+// ICalendar defines an ICalendar struct, it is an iCalendar object defined by RFC 5545,
+// see https://datatracker.ietf.org/doc/html/rfc5545.
+// 定义 ICalendar 结构体，即 RFC 5545 定义的 iCalendar 对象
+type ICalendar struct {
+	ProdID     string
+	Components []ICalComponent
+	Error      error
+}
+
+// ICalComponent defines an ICalComponent struct, it is a VEVENT or VTODO component.
+// End is the DTEND of VEVENT or the DUE of VTODO, AllDay means Start and End are DATE values,
+// Properties are the other content lines kept as they are, such as "RRULE:FREQ=WEEKLY".
+// 定义 ICalComponent 结构体，即 VEVENT 或 VTODO 组件，End 为 VEVENT 的 DTEND 或 VTODO 的 DUE，AllDay 表示全天，Properties 为原样保留的其他内容行
+type ICalComponent struct {
+	Type        string
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Stamp       Carbon
+	Start       Carbon
+	End         Carbon
+	AllDay      bool
+	Properties  []string
+}
+
+// NewICalendar returns a new ICalendar instance with some components.
+// 初始化 ICalendar 结构体
+func NewICalendar(components ...ICalComponent) ICalendar {
+	return ICalendar{ProdID: defaultICalProdID}.Add(components...)
+}
+
+// ParseICalendar parses the content of an .ics file as an ICalendar instance, only VEVENT and VTODO components are kept.
+// The timezone is used for the floating date-times which have neither TZID nor UTC designator,
+// a TZID unknown to Go falls back to a fixed zone with the standard offset of its VTIMEZONE.
+// 解析 .ics 文件内容为 ICalendar 实例，只保留 VEVENT 和 VTODO 组件，时区用于既没有 TZID 也没有 UTC 标识的时间
+func ParseICalendar(value string, timezone ...string) ICalendar {
+	ic := ICalendar{}
+	base := NewCarbon()
+	if len(timezone) > 0 {
+		base.loc, ic.Error = getLocationByTimezone(timezone[0])
+	}
+	if ic.Error != nil {
+		return ic
+	}
+	lines := parseRecurrenceLines(value)
+	zones := parseICalTimezones(lines)
+	stack := make([]string, 0)
+	hasCalendar, depth, duration := false, 0, ""
+	var component *ICalComponent
+	for _, line := range lines {
+		switch line.name {
+		case "BEGIN":
+			name := strings.ToUpper(strings.TrimSpace(line.value))
+			stack = append(stack, name)
+			switch {
+			case component != nil:
+				component.Properties = append(component.Properties, line.raw)
+			case name == "VCALENDAR":
+				hasCalendar = true
+			case name == ICalEvent || name == ICalTodo:
+				component, depth, duration = &ICalComponent{Type: name}, len(stack), ""
+			}
+			continue
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1] != strings.ToUpper(strings.TrimSpace(line.value)) {
+				ic.Error = invalidICalendarError(line.raw)
+				return ic
+			}
+			switch {
+			case component != nil && len(stack) == depth:
+				if duration != "" && component.End.IsInvalid() {
+					component.End = component.Start.Add(ParseInterval(duration))
+				}
+				ic.Components = append(ic.Components, *component)
+				component = nil
+			case component != nil:
+				component.Properties = append(component.Properties, line.raw)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		if len(stack) == 0 {
+			ic.Error = invalidICalendarError(line.raw)
+			return ic
+		}
+		if component == nil {
+			if stack[len(stack)-1] == "VCALENDAR" && line.name == "PRODID" {
+				ic.ProdID = line.value
+			}
+			continue
+		}
+		// the properties of nested components such as VALARM are kept as they are
+		if len(stack) != depth {
+			component.Properties = append(component.Properties, line.raw)
+			continue
+		}
+		var err error
+		var isDate bool
+		switch line.name {
+		case "UID":
+			component.UID = line.value
+		case "SUMMARY":
+			component.Summary = icalTextUnescaper.Replace(line.value)
+		case "DESCRIPTION":
+			component.Description = icalTextUnescaper.Replace(line.value)
+		case "LOCATION":
+			component.Location = icalTextUnescaper.Replace(line.value)
+		case "DTSTAMP":
+			component.Stamp, _, err = parseICalDateTime(line, base, zones)
+		case "DTSTART":
+			component.Start, isDate, err = parseICalDateTime(line, base, zones)
+			component.AllDay = component.AllDay || isDate
+		case "DTEND", "DUE":
+			component.End, isDate, err = parseICalDateTime(line, base, zones)
+			component.AllDay = component.AllDay || isDate
+		case "DURATION":
+			duration = line.value
+		default:
+			component.Properties = append(component.Properties, line.raw)
+		}
+		if err != nil {
+			ic.Error = err
+			return ic
+		}
+	}
+	if !hasCalendar || len(stack) > 0 {
+		ic.Error = invalidICalendarError(value)
+	}
+	return ic
+}
+
+// Add adds some VEVENT or VTODO components, the type defaults to VEVENT.
+// 添加 VEVENT 或 VTODO 组件，类型默认为 VEVENT
+func (ic ICalendar) Add(components ...ICalComponent) ICalendar {
+	if ic.Error != nil {
+		return ic
+	}
+	result := make([]ICalComponent, len(ic.Components), len(ic.Components)+len(components))
+	copy(result, ic.Components)
+	for _, component := range components {
+		if component.Type == "" {
+			component.Type = ICalEvent
+		}
+		if component.Type != ICalEvent && component.Type != ICalTodo {
+			ic.Error = invalidICalendarError(component.Type)
+			return ic
+		}
+		// DTSTART is required in VEVENT but optional in VTODO
+		if component.Type == ICalEvent && component.Start.IsInvalid() {
+			ic.Error = component.Start.Error
+			if ic.Error == nil {
+				ic.Error = invalidICalendarError(component.Summary)
+			}
+			return ic
+		}
+		result = append(result, component)
+	}
+	ic.Components = result
+	return ic
+}
+
+// Events returns the VEVENT components.
+// 获取 VEVENT 组件
+func (ic ICalendar) Events() []ICalComponent {
+	return ic.filter(ICalEvent)
+}
+
+// Todos returns the VTODO components.
+// 获取 VTODO 组件
+func (ic ICalendar) Todos() []ICalComponent {
+	return ic.filter(ICalTodo)
+}
+
+// String implements the interface Stringer for ICalendar struct, it outputs the content of an .ics file,
+// a VTIMEZONE is derived from the Go zone rules for each TZID in use.
+// 实现 Stringer 接口，输出 .ics 文件内容，每个使用的 TZID 都会根据 Go 时区规则生成 VTIMEZONE
+func (ic ICalendar) String() string {
+	if ic.Error != nil {
+		return ""
+	}
+	prodID := ic.ProdID
+	if prodID == "" {
+		prodID = defaultICalProdID
+	}
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:" + prodID}
+
+	// the years in use of each location, the VTIMEZONE only covers these years
+	locs := make([]*time.Location, 0)
+	years := make(map[string][2]int)
+	for _, component := range ic.Components {
+		if component.AllDay {
+			continue
+		}
+		for _, c := range []Carbon{component.Start, component.End} {
+			if c.IsInvalid() || c.loc == time.Local || c.loc.String() == UTC {
+				continue
+			}
+			year := c.ToStdTime().Year()
+			span, ok := years[c.loc.String()]
+			if !ok {
+				locs, span = append(locs, c.loc), [2]int{year, year}
+			}
+			if year < span[0] {
+				span[0] = year
+			}
+			if year > span[1] {
+				span[1] = year
+			}
+			years[c.loc.String()] = span
+		}
+	}
+	for _, loc := range locs {
+		lines = append(lines, formatICalTimezone(loc, years[loc.String()][0], years[loc.String()][1])...)
+	}
+
+	for _, component := range ic.Components {
+		lines = append(lines, "BEGIN:"+component.Type)
+		if component.UID != "" {
+			lines = append(lines, "UID:"+component.UID)
+		}
+		stamp := component.Stamp
+		if stamp.IsInvalid() {
+			stamp = Now(UTC)
+		}
+		lines = append(lines, formatICalDateTime("DTSTAMP", stamp.SetLocation(time.UTC), false))
+		if component.Start.IsValid() {
+			lines = append(lines, formatICalDateTime("DTSTART", component.Start, component.AllDay))
+		}
+		if component.End.IsValid() {
+			name := "DTEND"
+			if component.Type == ICalTodo {
+				name = "DUE"
+			}
+			lines = append(lines, formatICalDateTime(name, component.End, component.AllDay))
+		}
+		if component.Summary != "" {
+			lines = append(lines, "SUMMARY:"+icalTextEscaper.Replace(component.Summary))
+		}
+		if component.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+icalTextEscaper.Replace(component.Description))
+		}
+		if component.Location != "" {
+			lines = append(lines, "LOCATION:"+icalTextEscaper.Replace(component.Location))
+		}
+		lines = append(lines, component.Properties...)
+		lines = append(lines, "END:"+component.Type)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(foldICalLine(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// gets the components of the type.
+// 获取指定类型的组件
+func (ic ICalendar) filter(typ string) []ICalComponent {
+	components := make([]ICalComponent, 0)
+	for _, component := range ic.Components {
+		if component.Type == typ {
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// formats the date-time property like "DTSTART;TZID=Asia/Shanghai:20200805T131415".
+// 格式化时间属性
+func formatICalDateTime(name string, c Carbon, allDay bool) string {
+	t := c.ToStdTime()
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE:" + t.Format(RFC5545DateLayout)
+	case c.loc.String() == UTC:
+		return name + ":" + t.Format(RFC5545DateTimeUTCLayout)
+	case c.loc == time.Local:
+		return name + ":" + t.Format(RFC5545DateTimeLayout)
+	}
+	return name + ";TZID=" + c.loc.String() + ":" + t.Format(RFC5545DateTimeLayout)
+}
+
+// parses the date-time property with TZID and VALUE parameters.
+// 解析带 TZID 和 VALUE 参数的时间属性
+func parseICalDateTime(line recurrenceLine, base Carbon, zones map[string]*time.Location) (c Carbon, isDate bool, err error) {
+	c = base
+	params := line.params
+	if tzid, ok := params["TZID"]; ok {
+		if c.loc, err = getLocationByTimezone(tzid); err != nil {
+			if zones[tzid] == nil {
+				return c, false, err
+			}
+			c.loc, err = zones[tzid], nil
+		}
+		params = map[string]string{"VALUE": params["VALUE"]}
+	}
+	c.time, c.loc, isDate, err = parseRecurrenceDateTime(line.value, params, c.loc)
+	if err != nil {
+		return c, false, invalidICalendarError(line.raw)
+	}
+	return c, isDate, nil
+}
+
+// parses the VTIMEZONE components as fixed zones with their standard offsets, they are used for the TZIDs unknown to Go.
+// 解析 VTIMEZONE 组件为标准偏移量的固定时区，用于 Go 无法识别的 TZID
+func parseICalTimezones(lines []recurrenceLine) map[string]*time.Location {
+	zones := make(map[string]*time.Location)
+	inTimezone, tzid, observance := false, "", ""
+	for _, line := range lines {
+		name := strings.ToUpper(line.value)
+		switch {
+		case line.name == "BEGIN" && name == "VTIMEZONE":
+			inTimezone, tzid = true, ""
+		case line.name == "END" && name == "VTIMEZONE":
+			inTimezone = false
+		case !inTimezone:
+		case line.name == "BEGIN":
+			observance = name
+		case line.name == "END":
+			observance = ""
+		case line.name == "TZID":
+			tzid = line.value
+		case line.name == "TZOFFSETTO" && tzid != "":
+			offset, ok := parseICalOffset(line.value)
+			if ok && (observance == "STANDARD" || zones[tzid] == nil) {
+				zones[tzid] = time.FixedZone(tzid, offset)
+			}
+		}
+	}
+	return zones
+}
+
+// formats the VTIMEZONE of the location from the Go zone rules, it contains every transition between the years.
+// 根据 Go 时区规则格式化 VTIMEZONE，包含起止年份内的每次时区转换
+func formatICalTimezone(loc *time.Location, from, to int) []string {
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + loc.String()}
+	start, end := time.Date(from, 1, 1, 0, 0, 0, 0, loc), time.Date(to+1, 1, 1, 0, 0, 0, 0, loc)
+	_, offset := start.Zone()
+	lines = append(lines, formatICalObservance(start, offset)...)
+	for t := start; t.Before(end); {
+		next := t.Add(24 * time.Hour)
+		name1, offset1 := t.Zone()
+		name2, offset2 := next.Zone()
+		if name1 == name2 && offset1 == offset2 && t.IsDST() == next.IsDST() {
+			t = next
+			continue
+		}
+		// finds the transition by binary search, the precision is second
+		lo, hi := t, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+			if name, offset := mid.Zone(); name == name1 && offset == offset1 && mid.IsDST() == t.IsDST() {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		lines = append(lines, formatICalObservance(hi, offset1)...)
+		t = hi
+	}
+	return append(lines, "END:VTIMEZONE")
+}
+
+// formats the STANDARD or DAYLIGHT observance starting at the time, the DTSTART is in the local time before the onset.
+// 格式化从指定时间开始的 STANDARD 或 DAYLIGHT 观测，DTSTART 为转换前的本地时间
+func formatICalObservance(t time.Time, offsetFrom int) []string {
+	name, offsetTo := t.Zone()
+	observance := "STANDARD"
+	if t.IsDST() {
+		observance = "DAYLIGHT"
+	}
+	return []string{
+		"BEGIN:" + observance,
+		"DTSTART:" + t.UTC().Add(time.Duration(offsetFrom)*time.Second).Format(RFC5545DateTimeLayout),
+		"TZOFFSETFROM:" + formatICalOffset(offsetFrom),
+		"TZOFFSETTO:" + formatICalOffset(offsetTo),
+		"TZNAME:" + name,
+		"END:" + observance,
+	}
+}
+
+// formats the offset in seconds like "+0800" or "+053328".
+// 格式化偏移秒数，如 "+0800"
+func formatICalOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	value := sign + time.Date(0, 1, 1, 0, 0, offset, 0, time.UTC).Format("1504")
+	if offset%SecondsPerMinute != 0 {
+		value += time.Date(0, 1, 1, 0, 0, offset, 0, time.UTC).Format("05")
+	}
+	return value
+}
+
+// parses the offset like "+0800" or "+053328" in seconds.
+// 解析偏移量为秒数
+func parseICalOffset(value string) (int, bool) {
+	if len(value) != 5 && len(value) != 7 || value[0] != '+' && value[0] != '-' {
+		return 0, false
+	}
+	offset := 0
+	for i, unit := range []int{SecondsPerHour, SecondsPerMinute, 1} {
+		if 1+i*2 >= len(value) {
+			break
+		}
+		n, err := strconv.Atoi(value[1+i*2 : 3+i*2])
+		if err != nil {
+			return 0, false
+		}
+		offset += n * unit
+	}
+	if value[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
+
+// folds the content line longer than 75 octets, the multi-octet characters are never split.
+// 折叠超过 75 字节的内容行，多字节字符不会被拆分
+func foldICalLine(line string) string {
+	var b strings.Builder
+	for limit := maxICalLineOctets; len(line) > limit; limit = maxICalLineOctets - 1 {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+	}
+	b.WriteString(line)
+	return b.String()
+}
@@ -0,0 +1,26 @@
+package carbon
+
+import "testing"
+
+func BenchmarkNewICalendar(b *testing.B) {
+	start := Parse("2020-08-05 13:14:15", NewYork)
+	for n := 0; n < b.N; n++ {
+		NewICalendar(ICalComponent{Start: start, End: start.AddHour()})
+	}
+}
+
+func BenchmarkICalendar_String(b *testing.B) {
+	start := Parse("2020-08-05 13:14:15", NewYork)
+	ic := NewICalendar(ICalComponent{Summary: "meeting", Start: start, End: start.AddHour()})
+	for n := 0; n < b.N; n++ {
+		_ = ic.String()
+	}
+}
+
+func BenchmarkParseICalendar(b *testing.B) {
+	start := Parse("2020-08-05 13:14:15", NewYork)
+	value := NewICalendar(ICalComponent{Summary: "meeting", Start: start, End: start.AddHour()}).String()
+	for n := 0; n < b.N; n++ {
+		ParseICalendar(value)
+	}
+}
@@ -0,0 +1,283 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestICalendar_String(t *testing.T) {
+	ic := NewICalendar(
+		ICalComponent{
+			UID:        "1@carbon",
+			Summary:    "Weekly meeting, room 1; bring\nnotes",
+			Stamp:      Parse("2024-01-01 00:00:00", UTC),
+			Start:      Parse("2024-03-08 09:00:00", NewYork),
+			End:        Parse("2024-03-08 10:00:00", NewYork),
+			Properties: []string{"RRULE:FREQ=WEEKLY;COUNT=2"},
+		},
+		ICalComponent{
+			UID:      "2@carbon",
+			Location: "Shanghai",
+			Stamp:    Parse("2024-01-01 00:00:00", UTC),
+			Start:    Parse("2024-05-01 01:00:00", UTC),
+			End:      Parse("2024-05-01 02:00:00", PRC),
+		},
+		ICalComponent{
+			Type:   ICalTodo,
+			UID:    "3@carbon",
+			Stamp:  Parse("2024-01-01 00:00:00", UTC),
+			End:    Parse("2024-11-05", PRC),
+			AllDay: true,
+		},
+	)
+	assert.Nil(t, ic.Error)
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//golang-module//carbon//EN",
+		"BEGIN:VTIMEZONE",
+		"TZID:America/New_York",
+		"BEGIN:STANDARD",
+		"DTSTART:20240101T000000",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0500",
+		"TZNAME:EST",
+		"END:STANDARD",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20240310T020000",
+		"TZOFFSETFROM:-0500",
+		"TZOFFSETTO:-0400",
+		"TZNAME:EDT",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"DTSTART:20241103T020000",
+		"TZOFFSETFROM:-0400",
+		"TZOFFSETTO:-0500",
+		"TZNAME:EST",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VTIMEZONE",
+		"TZID:PRC",
+		"BEGIN:STANDARD",
+		"DTSTART:20240101T000000",
+		"TZOFFSETFROM:+0800",
+		"TZOFFSETTO:+0800",
+		"TZNAME:CST",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:1@carbon",
+		"DTSTAMP:20240101T000000Z",
+		"DTSTART;TZID=America/New_York:20240308T090000",
+		"DTEND;TZID=America/New_York:20240308T100000",
+		`SUMMARY:Weekly meeting\, room 1\; bring\nnotes`,
+		"RRULE:FREQ=WEEKLY;COUNT=2",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:2@carbon",
+		"DTSTAMP:20240101T000000Z",
+		"DTSTART:20240501T010000Z",
+		"DTEND;TZID=PRC:20240501T020000",
+		"LOCATION:Shanghai",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"UID:3@carbon",
+		"DTSTAMP:20240101T000000Z",
+		"DUE;VALUE=DATE:20241105",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n") + "\r\n"
+	assert.Equal(t, expected, ic.String())
+	assert.Equal(t, expected, ParseICalendar(expected).String())
+}
+
+func TestICalendar_Fold(t *testing.T) {
+	assert := assert.New(t)
+
+	ic := NewICalendar(ICalComponent{
+		Summary: strings.Repeat("春节", 20),
+		Stamp:   Parse("2024-01-01 00:00:00", UTC),
+		Start:   Parse("2024-02-10", UTC),
+		AllDay:  true,
+	})
+	lines := strings.Split(ic.String(), "\r\n")
+	for index, line := range lines {
+		assert.LessOrEqual(len(line), 75, "Current test index is "+strconv.Itoa(index))
+	}
+	assert.Contains(lines, "SUMMARY:"+strings.Repeat("春节", 11))
+	assert.Contains(lines, " "+strings.Repeat("春节", 9))
+	assert.Equal(strings.Repeat("春节", 20), ParseICalendar(ic.String()).Components[0].Summary)
+}
+
+func TestICalendar_TrailingWhitespace(t *testing.T) {
+	assert := assert.New(t)
+
+	ic := NewICalendar(ICalComponent{
+		Summary:     "Meeting  ",
+		Description: "notes\t",
+		Stamp:       Parse("2024-01-01 00:00:00", UTC),
+		Start:       Parse("2024-02-10", UTC),
+		AllDay:      true,
+	})
+	parsed := ParseICalendar(ic.String())
+	assert.Nil(parsed.Error)
+	assert.Equal("Meeting  ", parsed.Components[0].Summary)
+	assert.Equal("notes\t", parsed.Components[0].Description)
+}
+
+func TestParseICalendar_QuotedParameter(t *testing.T) {
+	assert := assert.New(t)
+
+	ic := ParseICalendar(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART:20200805T131415Z",
+		`DESCRIPTION;ALTREP="http://example.com/a;b":Meeting notes`,
+		`ATTENDEE;CN="Doe: John";ROLE=CHAIR:mailto:john@example.com`,
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n"))
+	assert.Nil(ic.Error)
+	assert.Equal("Meeting notes", ic.Components[0].Description)
+	assert.Equal([]string{`ATTENDEE;CN="Doe: John";ROLE=CHAIR:mailto:john@example.com`}, ic.Components[0].Properties)
+
+	lines := parseRecurrenceLines(`ATTENDEE;CN="Doe: John";ROLE=CHAIR:mailto:john@example.com`)
+	assert.Equal("ATTENDEE", lines[0].name)
+	assert.Equal("Doe: John", lines[0].params["CN"])
+	assert.Equal("CHAIR", lines[0].params["ROLE"])
+	assert.Equal("mailto:john@example.com", lines[0].value)
+}
+
+func TestParseICalendar(t *testing.T) {
+	assert := assert.New(t)
+
+	ic := ParseICalendar(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"PRODID:-//Example//EN",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:China Standard Time",
+		"BEGIN:STANDARD",
+		"DTSTART:16010101T000000",
+		"TZOFFSETFROM:+0800",
+		"TZOFFSETTO:+0800",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:1@example.com",
+		"DTSTAMP:20200805T131415Z",
+		"DTSTART;TZID=America/New_York:20240310T023000",
+		"DURATION:PT1H",
+		"SUMMARY:Long sum",
+		" mary\\, folded",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=\"China Standard Time\":20200805T131415",
+		"DTEND:20200805T141415",
+		"DESCRIPTION:line1\\nline2",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"DTSTART;VALUE=DATE:20200805",
+		"DUE;VALUE=DATE:20200806",
+		"END:VTODO",
+		"END:VCALENDAR",
+	}, "\r\n"), UTC)
+	assert.Nil(ic.Error)
+	assert.Equal("-//Example//EN", ic.ProdID)
+	assert.Len(ic.Events(), 2)
+	assert.Len(ic.Todos(), 1)
+
+	event := ic.Components[0]
+	assert.Equal(ICalEvent, event.Type)
+	assert.Equal("1@example.com", event.UID)
+	assert.Equal("Long summary, folded", event.Summary)
+	assert.Equal("2020-08-05T13:14:15Z", event.Stamp.ToRfc3339String())
+	assert.Equal("2024-03-10T03:30:00-04:00", event.Start.ToRfc3339String())
+	assert.Equal("2024-03-10T04:30:00-04:00", event.End.ToRfc3339String())
+	assert.Equal(NewYork, event.Start.Location())
+	assert.False(event.AllDay)
+	assert.Equal([]string{"BEGIN:VALARM", "ACTION:DISPLAY", "TRIGGER:-PT15M", "END:VALARM"}, event.Properties)
+
+	event = ic.Components[1]
+	assert.Equal("2020-08-05T13:14:15+08:00", event.Start.ToRfc3339String())
+	assert.Equal("China Standard Time", event.Start.Location())
+	assert.Equal("2020-08-05T14:14:15Z", event.End.ToRfc3339String())
+	assert.Equal("line1\nline2", event.Description)
+	assert.True(event.Stamp.IsInvalid())
+
+	todo := ic.Components[2]
+	assert.Equal(ICalTodo, todo.Type)
+	assert.True(todo.AllDay)
+	assert.Equal("2020-08-05T00:00:00Z", todo.Start.ToRfc3339String())
+	assert.Equal("2020-08-06T00:00:00Z", todo.End.ToRfc3339String())
+}
+
+func TestICalendar_Add(t *testing.T) {
+	assert := assert.New(t)
+
+	ic1 := NewICalendar()
+	ic2 := ic1.Add(ICalComponent{Start: Parse("2020-08-05")})
+	ic3 := ic2.Add(ICalComponent{Type: ICalTodo})
+	assert.Len(ic1.Components, 0)
+	assert.Len(ic2.Components, 1)
+	assert.Len(ic3.Components, 2)
+	assert.Equal(ICalEvent, ic3.Components[0].Type)
+	assert.Equal(ICalTodo, ic3.Components[1].Type)
+}
+
+func TestICalendar_Offset(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		offset   int
+		expected string
+	}{
+		0: {0, "+0000"},
+		1: {28800, "+0800"},
+		2: {-18000, "-0500"},
+		3: {19800, "+0530"},
+		4: {29143, "+080543"},
+		5: {-3661, "-010101"},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.expected, formatICalOffset(test.offset), "Current test index is "+strconv.Itoa(index))
+		offset, ok := parseICalOffset(test.expected)
+		assert.True(ok, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.offset, offset, "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestError_ICalendar(t *testing.T) {
+	values := []string{
+		"",
+		"BEGIN:VEVENT\r\nEND:VEVENT",
+		"SUMMARY:xxx",
+		"BEGIN:VCALENDAR",
+		"BEGIN:VCALENDAR\r\nEND:VEVENT",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:xxx\r\nEND:VEVENT\r\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;TZID=xxx:20200805T131415\r\nEND:VEVENT\r\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\nSUMMARY:xxx",
+	}
+	for index, value := range values {
+		ic := ParseICalendar(value)
+		assert.NotNil(t, ic.Error, "It should catch an exception in ParseICalendar(), current test index is "+strconv.Itoa(index))
+		assert.Empty(t, ic.String())
+	}
+
+	assert.NotNil(t, ParseICalendar("BEGIN:VCALENDAR\r\nEND:VCALENDAR", "xxx").Error, "It should catch an exception in ParseICalendar()")
+	assert.NotNil(t, NewICalendar(ICalComponent{Type: "VJOURNAL", Start: Now()}).Error, "It should catch an exception in NewICalendar()")
+	assert.NotNil(t, NewICalendar(ICalComponent{Summary: "xxx"}).Error, "It should catch an exception in NewICalendar()")
+	assert.NotNil(t, NewICalendar(ICalComponent{Start: Parse("xxx")}).Error, "It should catch an exception in NewICalendar()")
+	for index, value := range []string{"", "0800", "+08", "+08:00", "+08xx", "+0800x"} {
+		_, ok := parseICalOffset(value)
+		assert.False(t, ok, "Current test index is "+strconv.Itoa(index))
+	}
+}
@@ -0,0 +1,512 @@
+package carbon
+
+import (
+	"bytes"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// iso8601 duration pattern like "P1Y2M10DT2H30M"
+	// iso8601 时长正则
+	isoIntervalPattern = regexp.MustCompile(`^([-+])?P(?:([-+]?\d+)Y)?(?:([-+]?\d+)M)?(?:([-+]?\d+)W)?(?:([-+]?\d+)D)?(?:T(?:([-+]?\d+(?:[.,]\d+)?)H)?(?:([-+]?\d+(?:[.,]\d+)?)M)?(?:([-+]?\d+(?:[.,]\d+)?)S)?)?$`)
+
+	// human-readable interval pattern like "3 days"
+	// 可读时长正则
+	humanIntervalPattern = regexp.MustCompile(`([-+]?\d+(?:\.\d+)?)\s*([a-zA-Zµ]+)`)
+
+	// human-readable interval separator pattern
+	// 可读时长分隔符正则
+	humanIntervalSeparatorPattern = regexp.MustCompile(`^(?:\s|,|and)*$`)
+)
+
+// Interval defines an Interval struct.
+// 定义 Interval 结构体
+type Interval struct {
+	years, months, weeks, days           int
+	hours, minutes, seconds, nanoseconds int
+	lang                                 *Language
+	Error                                error
+}
+
+// NewInterval returns a new empty Interval instance.
+// 初始化 Interval 结构体
+func NewInterval() Interval {
+	return Interval{lang: NewLanguage()}
+}
+
+// CreateInterval creates an Interval instance from the given years, months, weeks, days, hours, minutes and seconds.
+// 从给定的年、月、周、日、时、分、秒创建 Interval 实例
+func CreateInterval(years, months, weeks, days, hours, minutes, seconds int) Interval {
+	i := NewInterval()
+	i.years, i.months, i.weeks, i.days = years, months, weeks, days
+	i.hours, i.minutes, i.seconds = hours, minutes, seconds
+	return i
+}
+
+// ParseInterval parses an ISO8601 duration string like "P1Y2M10DT2H30M" or a human-readable string like "3 days" as an Interval instance.
+// 将 ISO8601 时长字符串或可读时长字符串解析成 Interval 实例
+func ParseInterval(value string) Interval {
+	i := NewInterval()
+	value = strings.TrimSpace(value)
+	if value == "" {
+		i.Error = invalidIntervalError(value)
+		return i
+	}
+	if matches := isoIntervalPattern.FindStringSubmatch(value); matches != nil {
+		return i.parseIso8601(value, matches)
+	}
+	return i.parseHuman(value)
+}
+
+// parses an ISO8601 duration string.
+// 解析 ISO8601 时长字符串
+func (i Interval) parseIso8601(value string, matches []string) Interval {
+	// at least one unit is required, and so is a time unit after the time designator
+	if strings.HasSuffix(value, "P") || strings.HasSuffix(value, "T") {
+		i.Error = invalidIntervalError(value)
+		return i
+	}
+	integers := make([]int, 4)
+	for index := 0; index < 4; index++ {
+		if matches[index+2] == "" {
+			continue
+		}
+		integer, err := strconv.Atoi(matches[index+2])
+		if err != nil {
+			i.Error = invalidIntervalError(value)
+			return i
+		}
+		integers[index] = integer
+	}
+	i.years, i.months, i.weeks, i.days = integers[0], integers[1], integers[2], integers[3]
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+	for index, unit := range units {
+		if matches[index+6] == "" {
+			continue
+		}
+		number, _ := strconv.ParseFloat(strings.Replace(matches[index+6], ",", ".", 1), 64)
+		duration, ok := toIntervalDuration(number, unit)
+		if !ok {
+			i.Error = invalidIntervalError(value)
+			return i
+		}
+		i = i.addDuration(duration)
+	}
+	if matches[1] == "-" {
+		i = i.negate()
+	}
+	return i
+}
+
+// parses a human-readable interval string.
+// 解析可读时长字符串
+func (i Interval) parseHuman(value string) Interval {
+	indexes := humanIntervalPattern.FindAllStringSubmatchIndex(value, -1)
+	if len(indexes) == 0 {
+		i.Error = invalidIntervalError(value)
+		return i
+	}
+	offset := 0
+	for _, index := range indexes {
+		if !humanIntervalSeparatorPattern.MatchString(strings.ToLower(value[offset:index[0]])) {
+			i.Error = invalidIntervalError(value)
+			return i
+		}
+		offset = index[1]
+		number, unit := value[index[2]:index[3]], strings.ToLower(value[index[4]:index[5]])
+		integer, err := strconv.Atoi(number)
+		isInteger := err == nil
+		decimal, _ := strconv.ParseFloat(number, 64)
+		addUnit := func(unit time.Duration) bool {
+			duration, ok := toIntervalDuration(decimal, unit)
+			i = i.addDuration(duration)
+			return ok
+		}
+		switch unit {
+		case "y", "yr", "yrs", "year", "years":
+			i.years += integer
+		case "mo", "mon", "mons", "month", "months":
+			i.months += integer
+		case "w", "wk", "wks", "week", "weeks":
+			i.weeks += integer
+		case "d", "day", "days":
+			i.days += integer
+		case "h", "hr", "hrs", "hour", "hours":
+			isInteger = addUnit(time.Hour)
+		case "m", "min", "mins", "minute", "minutes":
+			isInteger = addUnit(time.Minute)
+		case "s", "sec", "secs", "second", "seconds":
+			isInteger = addUnit(time.Second)
+		case "ms", "millisecond", "milliseconds":
+			isInteger = addUnit(time.Millisecond)
+		case "us", "µs", "microsecond", "microseconds":
+			isInteger = addUnit(time.Microsecond)
+		case "ns", "nanosecond", "nanoseconds":
+			isInteger = addUnit(time.Nanosecond)
+		default:
+			isInteger = false
+		}
+		if !isInteger {
+			i.Error = invalidIntervalError(value)
+			return i
+		}
+	}
+	if !humanIntervalSeparatorPattern.MatchString(strings.ToLower(value[offset:])) {
+		i.Error = invalidIntervalError(value)
+	}
+	return i
+}
+
+// adds a duration to the hours, minutes, seconds and nanoseconds.
+// 增加时长到时、分、秒、纳秒
+func (i Interval) addDuration(duration time.Duration) Interval {
+	i.hours += int(duration / time.Hour)
+	duration %= time.Hour
+	i.minutes += int(duration / time.Minute)
+	duration %= time.Minute
+	i.seconds += int(duration / time.Second)
+	duration %= time.Second
+	i.nanoseconds += int(duration)
+	return i
+}
+
+// converts the number of the unit to a duration, it reports false if the duration overflows.
+// 将单位数量转换为时长，溢出时返回 false
+func toIntervalDuration(number float64, unit time.Duration) (time.Duration, bool) {
+	duration := number * float64(unit)
+	if duration >= math.MaxInt64 || duration <= math.MinInt64 {
+		return 0, false
+	}
+	return time.Duration(duration), true
+}
+
+// returns a negated Interval instance.
+// 返回取反的 Interval 实例
+func (i Interval) negate() Interval {
+	i.years, i.months, i.weeks, i.days = -i.years, -i.months, -i.weeks, -i.days
+	i.hours, i.minutes, i.seconds, i.nanoseconds = -i.hours, -i.minutes, -i.seconds, -i.nanoseconds
+	return i
+}
+
+// SetLocale sets locale.
+// 设置语言区域
+func (i Interval) SetLocale(locale string) Interval {
+	if i.Error != nil {
+		return i
+	}
+	i.lang = NewLanguage()
+	i.lang.SetLocale(locale)
+	i.Error = i.lang.Error
+	return i
+}
+
+// SetLanguage sets language.
+// 设置语言对象
+func (i Interval) SetLanguage(lang *Language) Interval {
+	if i.Error != nil {
+		return i
+	}
+	i.lang, i.Error = lang, lang.Error
+	return i
+}
+
+// Years gets years like 1.
+// 获取年数
+func (i Interval) Years() int {
+	return i.years
+}
+
+// Months gets months like 2.
+// 获取月数
+func (i Interval) Months() int {
+	return i.months
+}
+
+// Weeks gets weeks like 1.
+// 获取周数
+func (i Interval) Weeks() int {
+	return i.weeks
+}
+
+// Days gets days like 10.
+// 获取天数
+func (i Interval) Days() int {
+	return i.days
+}
+
+// Hours gets hours like 2.
+// 获取小时数
+func (i Interval) Hours() int {
+	return i.hours
+}
+
+// Minutes gets minutes like 30.
+// 获取分钟数
+func (i Interval) Minutes() int {
+	return i.minutes
+}
+
+// Seconds gets seconds like 15.
+// 获取秒数
+func (i Interval) Seconds() int {
+	return i.seconds
+}
+
+// Nanoseconds gets nanoseconds like 999999999.
+// 获取纳秒数
+func (i Interval) Nanoseconds() int {
+	return i.nanoseconds
+}
+
+// IsZero reports whether is zero interval.
+// 是否是零值时长
+func (i Interval) IsZero() bool {
+	return i.years == 0 && i.months == 0 && i.weeks == 0 && i.days == 0 &&
+		i.hours == 0 && i.minutes == 0 && i.seconds == 0 && i.nanoseconds == 0
+}
+
+// CascadeIntervals converts overflowed units into bigger units, such as "PT36H" to "P1DT12H".
+// 将溢出的单位转换为更大的单位，如 "PT36H" 转换为 "P1DT12H"
+func (i Interval) CascadeIntervals() Interval {
+	if i.Error != nil {
+		return i
+	}
+	months := i.years*MonthsPerYear + i.months
+	i.years, i.months = months/MonthsPerYear, months%MonthsPerYear
+
+	nanoseconds := ((((int64(i.weeks)*DaysPerWeek+int64(i.days))*HoursPerDay+int64(i.hours))*MinutesPerHour+int64(i.minutes))*SecondsPerMinute+int64(i.seconds))*1e9 + int64(i.nanoseconds)
+	sign := int64(1)
+	if nanoseconds < 0 {
+		sign, nanoseconds = -1, -nanoseconds
+	}
+	i.nanoseconds = int(sign * (nanoseconds % 1e9))
+	seconds := nanoseconds / 1e9
+	i.seconds = int(sign * (seconds % SecondsPerMinute))
+	i.minutes = int(sign * (seconds / SecondsPerMinute % MinutesPerHour))
+	i.hours = int(sign * (seconds / SecondsPerHour % HoursPerDay))
+	i.days = int(sign * (seconds / SecondsPerDay % DaysPerWeek))
+	i.weeks = int(sign * (seconds / SecondsPerWeek))
+	return i
+}
+
+// ToIso8601String outputs a string in ISO8601 duration format like "P1Y2M10DT2H30M".
+// 输出 ISO8601 时长格式字符串
+func (i Interval) ToIso8601String() string {
+	if i.Error != nil {
+		return ""
+	}
+	if i.IsZero() {
+		return "PT0S"
+	}
+	buffer := bytes.NewBufferString("P")
+	// negative intervals are prefixed with a minus sign if all the units are not positive
+	if i.isNegative() {
+		buffer = bytes.NewBufferString("-P")
+		i = i.negate()
+	}
+	units := []struct {
+		value  int
+		symbol string
+	}{
+		{i.years, "Y"}, {i.months, "M"}, {i.weeks, "W"}, {i.days, "D"},
+	}
+	for _, unit := range units {
+		if unit.value != 0 {
+			buffer.WriteString(strconv.Itoa(unit.value) + unit.symbol)
+		}
+	}
+	if i.hours == 0 && i.minutes == 0 && i.seconds == 0 && i.nanoseconds == 0 {
+		return buffer.String()
+	}
+	buffer.WriteString("T")
+	if i.hours != 0 {
+		buffer.WriteString(strconv.Itoa(i.hours) + "H")
+	}
+	if i.minutes != 0 {
+		buffer.WriteString(strconv.Itoa(i.minutes) + "M")
+	}
+	if i.seconds != 0 || i.nanoseconds != 0 {
+		seconds := time.Duration(i.seconds)*time.Second + time.Duration(i.nanoseconds)
+		buffer.WriteString(strconv.FormatFloat(seconds.Seconds(), 'f', -1, 64) + "S")
+	}
+	return buffer.String()
+}
+
+// String implements the interface Stringer for Interval struct.
+// 实现 Stringer 接口
+func (i Interval) String() string {
+	return i.ToIso8601String()
+}
+
+// ForHumans outputs a string in human-readable format like "1 year 2 months 10 days", i18n is supported.
+// 输出对人类友好的可读格式字符串，支持i18n
+func (i Interval) ForHumans() string {
+	if i.Error != nil {
+		return ""
+	}
+	if i.lang == nil {
+		i.lang = NewLanguage()
+	}
+	units := []struct {
+		value int
+		unit  string
+	}{
+		{i.years, "year"}, {i.months, "month"}, {i.weeks, "week"}, {i.days, "day"},
+		{i.hours, "hour"}, {i.minutes, "minute"}, {i.seconds, "second"},
+	}
+	translations := make([]string, 0, len(units))
+	for _, unit := range units {
+		if unit.value != 0 {
+			translations = append(translations, i.lang.translate(unit.unit, int64(unit.value)))
+		}
+	}
+	if len(translations) == 0 {
+		if len(i.lang.resources) == 0 {
+			i.lang.SetLocale(defaultLocale)
+		}
+		slice := strings.Split(i.lang.resources["second"], "|")
+		return strings.Replace(slice[len(slice)-1], "%d", "0", 1)
+	}
+	return strings.Join(translations, " ")
+}
+
+// ToStdDuration converts the fixed units into standard time.Duration, years and months are ignored because they have variable lengths.
+// 将固定长度的单位转换成标准 time.Duration，年、月因长度不固定而被忽略
+func (i Interval) ToStdDuration() time.Duration {
+	if i.Error != nil {
+		return 0
+	}
+	return time.Duration(i.weeks)*DaysPerWeek*HoursPerDay*time.Hour + time.Duration(i.days)*HoursPerDay*time.Hour +
+		time.Duration(i.hours)*time.Hour + time.Duration(i.minutes)*time.Minute +
+		time.Duration(i.seconds)*time.Second + time.Duration(i.nanoseconds)
+}
+
+// reports whether all the units are not positive and at least one unit is negative.
+// 是否所有单位都不为正且至少有一个单位为负
+func (i Interval) isNegative() bool {
+	units := []int{i.years, i.months, i.weeks, i.days, i.hours, i.minutes, i.seconds, i.nanoseconds}
+	isNegative := false
+	for _, unit := range units {
+		if unit > 0 {
+			return false
+		}
+		if unit < 0 {
+			isNegative = true
+		}
+	}
+	return isNegative
+}
+
+// Add adds an interval.
+// 增加时长
+func (c Carbon) Add(interval Interval) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if interval.Error != nil {
+		c.Error = interval.Error
+		return c
+	}
+	c.time = c.ToStdTime().AddDate(interval.years, interval.months, interval.weeks*DaysPerWeek+interval.days)
+	c.time = c.time.Add(interval.clock())
+	return c
+}
+
+// AddNoOverflow adds an interval without overflowing month.
+// 增加时长(月份不溢出)
+func (c Carbon) AddNoOverflow(interval Interval) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	if interval.Error != nil {
+		c.Error = interval.Error
+		return c
+	}
+	c = c.AddMonthsNoOverflow(interval.years*MonthsPerYear + interval.months)
+	c.time = c.ToStdTime().AddDate(0, 0, interval.weeks*DaysPerWeek+interval.days)
+	c.time = c.time.Add(interval.clock())
+	return c
+}
+
+// Sub subtracts an interval.
+// 减少时长
+func (c Carbon) Sub(interval Interval) Carbon {
+	return c.Add(interval.negate())
+}
+
+// SubNoOverflow subtracts an interval without overflowing month.
+// 减少时长(月份不溢出)
+func (c Carbon) SubNoOverflow(interval Interval) Carbon {
+	return c.AddNoOverflow(interval.negate())
+}
+
+// gets the hours, minutes, seconds and nanoseconds as a standard time.Duration.
+// 获取时、分、秒、纳秒对应的标准 time.Duration
+func (i Interval) clock() time.Duration {
+	return time.Duration(i.hours)*time.Hour + time.Duration(i.minutes)*time.Minute +
+		time.Duration(i.seconds)*time.Second + time.Duration(i.nanoseconds)
+}
+
+// Diff gets the difference as an Interval instance, adding it to the current time with Add gives exactly the end time.
+// 获取相差时长，使用 Add 将其加到当前时间上正好得到结束时间
+func (c Carbon) Diff(carbon ...Carbon) Interval {
+	end := c.Now()
+	if len(carbon) > 0 {
+		end = carbon[0]
+	}
+	return c.diffInterval(end, Carbon.AddMonths)
+}
+
+// gets the difference as an Interval instance by the given month traveler.
+// 通过给定的月份步进方法获取相差时长
+func (c Carbon) diffInterval(end Carbon, traveler func(c Carbon, months int) Carbon) Interval {
+	i := Interval{lang: c.lang}
+	if c.Error != nil || end.Error != nil {
+		i.Error = c.Error
+		if i.Error == nil {
+			i.Error = end.Error
+		}
+		return i
+	}
+	if c.IsInvalid() || end.IsInvalid() {
+		return i
+	}
+	sign := 1
+	if end.Lt(c) {
+		sign = -1
+	}
+	// reports whether the time doesn't pass over the end time
+	notPassed := func(t Carbon) bool {
+		if sign > 0 {
+			return t.Lte(end)
+		}
+		return t.Gte(end)
+	}
+
+	startYear, startMonth, _ := c.Date()
+	endYear, endMonth, _ := end.SetLocation(c.loc).Date()
+	months := (endYear-startYear)*MonthsPerYear + endMonth - startMonth
+	for months != 0 && !notPassed(traveler(c, months)) {
+		months -= sign
+	}
+	for notPassed(traveler(c, months+sign)) {
+		months += sign
+	}
+	base := traveler(c, months)
+
+	days := int(end.time.Sub(base.time).Hours() / HoursPerDay)
+	for days != 0 && !notPassed(base.AddDays(days)) {
+		days -= sign
+	}
+	for notPassed(base.AddDays(days + sign)) {
+		days += sign
+	}
+	base = base.AddDays(days)
+
+	i.years, i.months, i.days = months/MonthsPerYear, months%MonthsPerYear, days
+	return i.addDuration(end.time.Sub(base.time))
+}
@@ -0,0 +1,65 @@
+package carbon
+
+import "testing"
+
+func BenchmarkInterval_ParseInterval(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		ParseInterval("P1Y2M10DT2H30M")
+	}
+}
+
+func BenchmarkInterval_ToIso8601String(b *testing.B) {
+	i := ParseInterval("P1Y2M10DT2H30M")
+	for n := 0; n < b.N; n++ {
+		i.ToIso8601String()
+	}
+}
+
+func BenchmarkInterval_ForHumans(b *testing.B) {
+	i := ParseInterval("P1Y2M10DT2H30M")
+	for n := 0; n < b.N; n++ {
+		i.ForHumans()
+	}
+}
+
+func BenchmarkInterval_CascadeIntervals(b *testing.B) {
+	i := ParseInterval("PT100000S")
+	for n := 0; n < b.N; n++ {
+		i.CascadeIntervals()
+	}
+}
+
+func BenchmarkCarbon_Add(b *testing.B) {
+	now, i := Now(), ParseInterval("P1Y2M10DT2H30M")
+	for n := 0; n < b.N; n++ {
+		now.Add(i)
+	}
+}
+
+func BenchmarkCarbon_AddNoOverflow(b *testing.B) {
+	now, i := Now(), ParseInterval("P1Y2M10DT2H30M")
+	for n := 0; n < b.N; n++ {
+		now.AddNoOverflow(i)
+	}
+}
+
+func BenchmarkCarbon_Sub(b *testing.B) {
+	now, i := Now(), ParseInterval("P1Y2M10DT2H30M")
+	for n := 0; n < b.N; n++ {
+		now.Sub(i)
+	}
+}
+
+func BenchmarkCarbon_SubNoOverflow(b *testing.B) {
+	now, i := Now(), ParseInterval("P1Y2M10DT2H30M")
+	for n := 0; n < b.N; n++ {
+		now.SubNoOverflow(i)
+	}
+}
+
+func BenchmarkCarbon_Diff(b *testing.B) {
+	start, end := Parse("2020-08-05 13:14:15"), Parse("2021-10-15 15:44:15")
+	for n := 0; n < b.N; n++ {
+		start.Diff(end)
+	}
+}
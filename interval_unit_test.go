@@ -0,0 +1,238 @@
+package carbon
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterval_ParseInterval(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0:  {"P1Y2M10DT2H30M", "P1Y2M10DT2H30M"},
+		1:  {"P3W", "P3W"},
+		2:  {"PT36H", "PT36H"},
+		3:  {"PT1.5S", "PT1.5S"},
+		4:  {"PT0,5H", "PT30M"},
+		5:  {"-P1DT2H", "-P1DT2H"},
+		6:  {"P0D", "PT0S"},
+		7:  {"3 days", "P3D"},
+		8:  {"1 year 2 months", "P1Y2M"},
+		9:  {"1 year, 2 months and 3 days", "P1Y2M3D"},
+		10: {"2h30m", "PT2H30M"},
+		11: {"1.5 hours", "PT1H30M"},
+		12: {"-3 days", "-P3D"},
+		13: {"2 weeks 500ms", "P2WT0.5S"},
+	}
+
+	for index, test := range tests {
+		i := ParseInterval(test.input)
+		assert.Nil(i.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, i.ToIso8601String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, i.String(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestInterval_Getters(t *testing.T) {
+	assert := assert.New(t)
+
+	i := ParseInterval("P1Y2M3W4DT5H6M7.000000008S")
+	assert.Nil(i.Error)
+	assert.Equal(1, i.Years())
+	assert.Equal(2, i.Months())
+	assert.Equal(3, i.Weeks())
+	assert.Equal(4, i.Days())
+	assert.Equal(5, i.Hours())
+	assert.Equal(6, i.Minutes())
+	assert.Equal(7, i.Seconds())
+	assert.Equal(8, i.Nanoseconds())
+	assert.False(i.IsZero())
+	assert.True(NewInterval().IsZero())
+	assert.Equal(25*24*time.Hour+5*time.Hour+6*time.Minute+7*time.Second+8, i.ToStdDuration())
+}
+
+func TestInterval_CascadeIntervals(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Interval
+		expected string
+	}{
+		0: {CreateInterval(0, 0, 0, 0, 36, 0, 0), "P1DT12H"},
+		1: {CreateInterval(0, 14, 0, 10, 0, 0, 0), "P1Y2M1W3D"},
+		2: {CreateInterval(0, 0, 0, 0, 0, 90, 3600), "PT2H30M"},
+		3: {CreateInterval(1, -2, 0, 1, -2, 0, 0), "P10MT22H"},
+		4: {CreateInterval(0, 0, 0, -1, 2, 0, 0), "-PT22H"},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.expected, test.input.CascadeIntervals().ToIso8601String(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestInterval_ForHumans(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		locale   string
+		expected string
+	}{
+		0: {"P1Y2M3DT4H", "en", "1 year 2 months 3 days 4 hours"},
+		1: {"P1Y2M3DT4H", "zh-CN", "1 年 2 个月 3 天 4 小时"},
+		2: {"P2W", "de", "2 Wochen"},
+		3: {"PT0S", "en", "0 seconds"},
+		4: {"-P1D", "en", "-1 day"},
+	}
+
+	for index, test := range tests {
+		i := ParseInterval(test.input).SetLocale(test.locale)
+		assert.Nil(i.Error)
+		assert.Equal(test.expected, i.ForHumans(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	lang := NewLanguage()
+	lang.SetLocale("zh-CN")
+	assert.Equal("3 天", ParseInterval("P3D").SetLanguage(lang).ForHumans())
+}
+
+func TestCarbon_Add(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		interval string
+		expected string
+	}{
+		0: {"", "P1D", ""},
+		1: {"2020-08-05 13:14:15", "P1Y2M10DT2H30M", "2021-10-15 15:44:15"},
+		2: {"2020-01-31 13:14:15", "P1M", "2020-03-02 13:14:15"},
+		3: {"2020-02-29 13:14:15", "P1Y", "2021-03-01 13:14:15"},
+		4: {"2020-08-05 13:14:15", "-P1DT1H", "2020-08-04 12:14:15"},
+		5: {"2020-08-05 13:14:15", "3 weeks", "2020-08-26 13:14:15"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).Add(ParseInterval(test.interval))
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_AddNoOverflow(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		interval string
+		expected string
+	}{
+		0: {"", "P1D", ""},
+		1: {"2020-01-31 13:14:15", "P1M", "2020-02-29 13:14:15"},
+		2: {"2020-02-29 13:14:15", "P1Y", "2021-02-28 13:14:15"},
+		3: {"2020-01-31 13:14:15", "P1M1DT1H", "2020-03-01 14:14:15"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).AddNoOverflow(ParseInterval(test.interval))
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_Sub(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		interval string
+		expected string
+	}{
+		0: {"", "P1D", ""},
+		1: {"2021-10-15 15:44:15", "P1Y2M10DT2H30M", "2020-08-05 13:14:15"},
+		2: {"2020-03-31 13:14:15", "P1M", "2020-03-02 13:14:15"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).Sub(ParseInterval(test.interval))
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_SubNoOverflow(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		interval string
+		expected string
+	}{
+		0: {"", "P1D", ""},
+		1: {"2020-03-31 13:14:15", "P1M", "2020-02-29 13:14:15"},
+		2: {"2021-02-28 13:14:15", "P1Y", "2020-02-28 13:14:15"},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).SubNoOverflow(ParseInterval(test.interval))
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_Diff(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input1, input2 string
+		expected       string
+	}{
+		0: {"2020-08-05 13:14:15", "2020-08-05 13:14:15", "PT0S"},
+		1: {"2020-08-05 13:14:15", "2021-10-15 15:44:15", "P1Y2M10DT2H30M"},
+		2: {"2021-10-15 15:44:15", "2020-08-05 13:14:15", "-P1Y2M10DT2H30M"},
+		3: {"2020-01-31 00:00:00", "2020-02-29 00:00:00", "P29D"},
+		4: {"2020-01-31 00:00:00", "2020-03-02 00:00:00", "P1M"},
+		5: {"2020-08-05 23:00:00", "2020-08-06 01:00:00", "PT2H"},
+		6: {"2020-08-05 13:14:15.5", "2020-08-05 13:14:16", "PT0.5S"},
+	}
+
+	for index, test := range tests {
+		c1, c2 := Parse(test.input1), Parse(test.input2)
+		i := c1.Diff(c2)
+		assert.Nil(i.Error)
+		assert.Equal(test.expected, i.ToIso8601String(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(c2.ToDateTimeNanoString(), c1.Add(i).ToDateTimeNanoString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	var c Carbon
+	c.SetTestNow(Parse("2020-08-05 13:14:15"))
+	assert.Equal("P1D", c.Parse("2020-08-04 13:14:15").Diff().ToIso8601String())
+	assert.Equal("1 年", Parse("2020-08-05").SetLocale("zh-CN").Diff(Parse("2021-08-05")).ForHumans())
+}
+
+func TestError_Interval(t *testing.T) {
+	inputs := []string{"", "P", "PT", "P1.5D", "P1DT", "xxx", "3 days xxx", "1.5 days", "3 fortnights",
+		"P99999999999999999999Y", "P99999999999999999999D", "PT99999999999999999999H", "99999999999999999999 years", "99999999999999999999 hours"}
+	for _, input := range inputs {
+		assert.NotNil(t, ParseInterval(input).Error, "It should catch an exception in ParseInterval() with "+strconv.Quote(input))
+	}
+	assert.True(t, errors.Is(ParseInterval("P99999999999999999999Y").Error, ErrInvalidDuration))
+
+	i := ParseInterval("xxx")
+	assert.Equal(t, "", i.ToIso8601String(), "It should catch an exception in ToIso8601String()")
+	assert.Equal(t, "", i.ForHumans(), "It should catch an exception in ForHumans()")
+	assert.Equal(t, time.Duration(0), i.ToStdDuration(), "It should catch an exception in ToStdDuration()")
+	assert.NotNil(t, i.CascadeIntervals().Error, "It should catch an exception in CascadeIntervals()")
+	assert.NotNil(t, Parse("2020-08-05").Add(i).Error, "It should catch an exception in Add()")
+	assert.NotNil(t, Parse("2020-08-05").AddNoOverflow(i).Error, "It should catch an exception in AddNoOverflow()")
+	assert.NotNil(t, NewInterval().SetLocale("xxx").Error, "It should catch an exception in SetLocale()")
+	assert.NotNil(t, Parse("xxx").Diff(Parse("2020-08-05")).Error, "It should catch an exception in Diff()")
+	assert.NotNil(t, Parse("2020-08-05").Diff(Parse("xxx")).Error, "It should catch an exception in Diff()")
+}
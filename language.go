@@ -3,7 +3,6 @@ package carbon
 import (
 	"embed"
 	"encoding/json"
-	"fmt"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,6 +11,10 @@ import (
 //go:embed lang
 var fs embed.FS
 
+// max number of the cached localized patterns of a language
+// 每种语言最大缓存的本地化正则数
+const maxLocalizedPatterns = 64
+
 var (
 	// default directory
 	// 默认目录
@@ -23,8 +26,8 @@ var (
 
 	// invalid locale error
 	// 无效的区域错误
-	invalidLocaleError = func(locale string) error {
-		return fmt.Errorf("invalid locale file %q, please make sure the json file exists and is valid", locale)
+	invalidLocaleError = func(locale, file string) error {
+		return &LocaleError{Locale: locale, File: file}
 	}
 )
 
@@ -34,6 +37,8 @@ type Language struct {
 	dir       string
 	locale    string
 	resources map[string]string
+	relative  *localizedRelativeRules
+	patterns  map[string]*localizedPattern
 	Error     error
 	rw        *sync.RWMutex
 }
@@ -62,10 +67,11 @@ func (lang *Language) SetLocale(locale string) {
 	fileName := lang.dir + locale + ".json"
 	bytes, err := fs.ReadFile(fileName)
 	if err != nil {
-		lang.Error = invalidLocaleError(fileName)
+		lang.Error = invalidLocaleError(locale, fileName)
 		return
 	}
 	_ = json.Unmarshal(bytes, &lang.resources)
+	lang.relative, lang.patterns = nil, nil
 }
 
 // SetResources sets language resources.
@@ -74,6 +80,7 @@ func (lang *Language) SetResources(resources map[string]string) {
 	lang.rw.Lock()
 	defer lang.rw.Unlock()
 
+	lang.relative, lang.patterns = nil, nil
 	if len(lang.resources) == 0 {
 		lang.resources = resources
 		return
@@ -104,3 +111,84 @@ func (lang *Language) translate(unit string, value int64) string {
 	}
 	return strings.Replace(slice[number-1], "%d", strconv.FormatInt(value, 10), 1)
 }
+
+// gets the resources split by "|" like "lunar_months", the fallback is used if there are no such resources with the same length.
+// 获取以 "|" 分隔的资源，如 "lunar_months"，如果没有长度相同的该资源则使用 fallback
+func (lang *Language) getResources(key string, fallback []string) []string {
+	if lang == nil {
+		return fallback
+	}
+	if len(lang.resources) == 0 {
+		lang.SetLocale(defaultLocale)
+	}
+	lang.rw.RLock()
+	defer lang.rw.RUnlock()
+	if resource, ok := lang.resources[key]; ok {
+		if slice := strings.Split(resource, "|"); len(slice) == len(fallback) {
+			return slice
+		}
+	}
+	return fallback
+}
+
+// gets the value of the key in the resources like "holidays" whose items are like "christmas_day:圣诞节" split by "|".
+// 获取以 "|" 分隔、形如 "christmas_day:圣诞节" 的资源(如 "holidays")中键对应的值
+func (lang *Language) getKeyedResource(resource, key string) (string, bool) {
+	if lang == nil {
+		return "", false
+	}
+	if len(lang.resources) == 0 {
+		lang.SetLocale(defaultLocale)
+	}
+	lang.rw.RLock()
+	defer lang.rw.RUnlock()
+	for _, item := range strings.Split(lang.resources[resource], "|") {
+		if pair := strings.SplitN(item, ":", 2); len(pair) == 2 && pair[0] == key {
+			return pair[1], true
+		}
+	}
+	return "", false
+}
+
+// gets the localized relative rules built from the resources, they are built once until the resources are changed.
+// 获取由资源构建的本地化相对时间规则，资源变更前只构建一次
+func (lang *Language) getRelativeRules() *localizedRelativeRules {
+	lang.rw.RLock()
+	rules := lang.relative
+	lang.rw.RUnlock()
+	if rules != nil {
+		return rules
+	}
+	lang.rw.Lock()
+	defer lang.rw.Unlock()
+	if lang.relative == nil && len(lang.resources) > 0 {
+		lang.relative = newLocalizedRelativeRules(lang.resources)
+	}
+	return lang.relative
+}
+
+// gets the localized pattern of the format built from the resources, it is built once until the resources are changed.
+// 获取由资源构建的格式模板本地化正则，资源变更前只构建一次
+func (lang *Language) getLocalizedPattern(format string) *localizedPattern {
+	if len(lang.resources) == 0 {
+		lang.SetLocale(defaultLocale)
+	}
+	lang.rw.RLock()
+	pattern, ok := lang.patterns[format]
+	lang.rw.RUnlock()
+	if ok {
+		return pattern
+	}
+	lang.rw.Lock()
+	defer lang.rw.Unlock()
+	if pattern, ok = lang.patterns[format]; ok {
+		return pattern
+	}
+	// the patterns are dropped if there are too many formats
+	if lang.patterns == nil || len(lang.patterns) >= maxLocalizedPatterns {
+		lang.patterns = make(map[string]*localizedPattern)
+	}
+	pattern = newLocalizedPattern(lang.resources, format)
+	lang.patterns[format] = pattern
+	return pattern
+}
@@ -1737,6 +1737,8 @@ func TestCarbon_Format(t *testing.T) {
 		{"2020-08-05 01:14:15", "l", "ru", "Среда"},
 		{"2020-08-05 01:14:15", "F", "jp", "はちがつ"},
 		{"2020-08-05 01:14:15", "M", "zh-CN", "8月"},
+		{"2020-08-05 01:14:15", "A g:i", "zh-CN", "AM 1:14"},
+		{"2020-08-05 13:14:15", "a g:i", "jp", "pm 1:14"},
 
 		{"2020-08-05 13:14:15", "Y年m月d日", "en", "2020年08月05日"},
 		{"2020-08-05 01:14:15", "j", "en", "5"},
@@ -1,12 +1,16 @@
 package carbon
 
 import (
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
-// Parse parses a standard time string as a Carbon instance.
-// 将标准格式时间字符串解析成 Carbon 实例
+// Parse parses a standard time string or a relative expression like "+3 days" or "next monday" as a Carbon instance,
+// the relative expressions are not accepted in strict mode.
+// 将标准格式时间字符串或相对时间表达式解析成 Carbon 实例，严格模式下不接受相对时间表达式
 func (c Carbon) Parse(value string, timezone ...string) Carbon {
 	if value == "" || value == "0" || value == "0000-00-00 00:00:00" || value == "0000-00-00" || value == "00:00:00" {
 		return c
@@ -14,6 +18,9 @@ func (c Carbon) Parse(value string, timezone ...string) Carbon {
 	if len(timezone) > 0 {
 		c.loc, c.Error = getLocationByTimezone(timezone[0])
 	}
+	if c.Error != nil {
+		return c
+	}
 	switch value {
 	case "now":
 		return c.Now(timezone...)
@@ -22,12 +29,16 @@ func (c Carbon) Parse(value string, timezone ...string) Carbon {
 	case "tomorrow":
 		return c.Tomorrow(timezone...)
 	}
-	for _, layout := range layouts {
-		t, err := time.ParseInLocation(layout, value, c.loc)
-		if err == nil {
-			c.time = t
-			return c
-		}
+	if t, ok := c.parseByLayouts(value); ok {
+		c.time = t
+		return c
+	}
+	if c.strict {
+		c.Error = c.getFurthestParseError(value)
+		return c
+	}
+	if carbon, ok := c.parseRelative(value); ok {
+		return carbon
 	}
 	c.Error = invalidValueError(value)
 	return c
@@ -39,11 +50,16 @@ func Parse(value string, timezone ...string) Carbon {
 	return NewCarbon().Parse(value, timezone...)
 }
 
-// ParseByFormat parses a time string as a Carbon instance by format.
-// 通过格式模板将时间字符串解析成 Carbon 实例
+// ParseByFormat parses a time string as a Carbon instance by format, the localized month, weekday and meridiem names
+// of the F, M, l, D, a and A symbols are recognised by the language of the current instance.
+// 通过格式模板将时间字符串解析成 Carbon 实例，根据当前实例的语言识别本地化的月份、星期和上下午名称
 func (c Carbon) ParseByFormat(value, format string, timezone ...string) Carbon {
-	carbon := c.ParseByLayout(value, format2layout(format), timezone...)
-	if carbon.Error != nil {
+	delocalized, getOffset := c.delocalize(value, format)
+	carbon := c.ParseByLayout(delocalized, format2layout(format), timezone...)
+	switch e := carbon.Error.(type) {
+	case *ParseError:
+		e.Value, e.Offset = value, getOffset(e.Offset)
+	case *LayoutError:
 		carbon.Error = invalidFormatError(value, format)
 	}
 	return carbon
@@ -67,6 +83,15 @@ func (c Carbon) ParseByLayout(value, layout string, timezone ...string) Carbon {
 	if value == "" || value == "0" || value == "0000-00-00 00:00:00" || value == "0000-00-00" || value == "00:00:00" {
 		return c
 	}
+	if c.strict {
+		switch layout {
+		case "timestamp", "timestampMilli", "timestampMicro", "timestampNano":
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				c.Error = newTimestampParseError(value, layout, err)
+				return c
+			}
+		}
+	}
 	if layout == "timestamp" {
 		timestamp, _ := strconv.ParseInt(value, 10, 64)
 		return c.CreateFromTimestamp(timestamp)
@@ -83,7 +108,11 @@ func (c Carbon) ParseByLayout(value, layout string, timezone ...string) Carbon {
 		timestamp, _ := strconv.ParseInt(value, 10, 64)
 		return c.CreateFromTimestampNano(timestamp)
 	}
-	tt, err := time.ParseInLocation(layout, value, c.loc)
+	tt, err := c.parseInLayout(value, layout)
+	if e, ok := err.(*ParseError); ok {
+		c.Error = e
+		return c
+	}
 	if err != nil {
 		c.Error = invalidLayoutError(value, layout)
 		return c
@@ -97,3 +126,224 @@ func (c Carbon) ParseByLayout(value, layout string, timezone ...string) Carbon {
 func ParseByLayout(value, layout string, timezone ...string) Carbon {
 	return NewCarbon().ParseByLayout(value, layout, timezone...)
 }
+
+// parses the value by the layouts in order, only the candidate layouts of the value shape are tried,
+// so the result is the same as trying all layouts in order.
+// 按顺序使用布局模板解析，只尝试值形状对应的候选布局模板，结果与依次尝试所有布局模板相同
+func (c Carbon) parseByLayouts(value string) (time.Time, bool) {
+	for _, index := range getShapeLayouts(getLayoutShape(value)) {
+		if t, err := c.parseInLayout(value, layouts[index]); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// gets the indexes of the candidate layouts of the shape, they are the layouts which match the structure of the shape.
+// Whether a layout matches the structure only depends on the shape, so it is checked by parsing a canonical value of the shape
+// whose numbers are never out of range.
+// 获取形状对应的候选布局模板索引，即与形状结构匹配的布局模板
+func getShapeLayouts(shape string) []int {
+	layoutShapes.mu.Lock()
+	if element, ok := layoutShapes.candidates[shape]; ok {
+		layoutShapes.recent.MoveToFront(element)
+		layoutShapes.mu.Unlock()
+		return element.Value.(*layoutShape).indexes
+	}
+	layoutShapes.mu.Unlock()
+
+	canonical := getCanonicalValue(shape)
+	indexes := make([]int, 0)
+	for index, layout := range layouts {
+		if _, err := time.Parse(layout, canonical); err == nil {
+			indexes = append(indexes, index)
+		}
+	}
+
+	layoutShapes.mu.Lock()
+	defer layoutShapes.mu.Unlock()
+	if element, ok := layoutShapes.candidates[shape]; ok {
+		layoutShapes.recent.MoveToFront(element)
+		return indexes
+	}
+	layoutShapes.candidates[shape] = layoutShapes.recent.PushFront(&layoutShape{shape: shape, indexes: indexes})
+	if layoutShapes.recent.Len() > maxLayoutShapes {
+		oldest := layoutShapes.recent.Back()
+		layoutShapes.recent.Remove(oldest)
+		delete(layoutShapes.candidates, oldest.Value.(*layoutShape).shape)
+	}
+	return indexes
+}
+
+// gets the shape of the value which determines whether a layout matches its structure, the digits are replaced with "9",
+// except the signed numbers not greater than 23 like "+08" are replaced with "8", they are zone offsets in the "MST" layout.
+// 获取值的形状，数字被替换为 "9"，不大于 23 的带符号数字被替换为 "8"
+func getLayoutShape(value string) string {
+	shape := []byte(value)
+	for i := 0; i < len(shape); {
+		if shape[i] < '0' || shape[i] > '9' {
+			i++
+			continue
+		}
+		j, n := i, 0
+		for ; j < len(shape) && shape[j] >= '0' && shape[j] <= '9'; j++ {
+			if n <= 23 {
+				n = n*10 + int(shape[j]-'0')
+			}
+		}
+		digit := byte('9')
+		if i > 0 && (shape[i-1] == '+' || shape[i-1] == '-') && n <= 23 {
+			digit = '8'
+		}
+		for ; i < j; i++ {
+			shape[i] = digit
+		}
+	}
+	return string(shape)
+}
+
+// gets a canonical value of the shape whose numbers are in range, such as "1111-11-11 11:11:11 +0011",
+// the signed numbers greater than 23 start with "24" like "+2411".
+// 获取形状的规范值，其中的数字都不会超出范围
+func getCanonicalValue(shape string) string {
+	value := []byte(shape)
+	for i := 0; i < len(value); {
+		if value[i] != '8' && value[i] != '9' {
+			i++
+			continue
+		}
+		j := i
+		for ; j < len(value) && value[j] == shape[i]; j++ {
+		}
+		canonical := strings.Repeat("1", j-i)
+		switch {
+		case shape[i] == '8' && j-i > 2:
+			canonical = strings.Repeat("0", j-i-2) + "11"
+		case shape[i] == '9' && i > 0 && (shape[i-1] == '+' || shape[i-1] == '-'):
+			canonical = "24" + strings.Repeat("1", j-i-2)
+		}
+		copy(value[i:j], canonical)
+		i = j
+	}
+	return string(value)
+}
+
+// converts the localized month, weekday and meridiem names in the value to English ones by the symbols of the format,
+// so that they can be parsed by the standard layouts, it also returns a function which maps an offset in the converted value
+// to the offset in the original value.
+// 根据格式模板中的符号将值中本地化的月份、星期和上下午名称转换为英文名称，以便使用标准布局模板解析，同时返回将转换后的位置映射到原位置的函数
+func (c Carbon) delocalize(value, format string) (string, func(offset int) int) {
+	unchanged := func(offset int) int { return offset }
+	if c.lang == nil || !strings.ContainsAny(format, "FMlDaA") {
+		return value, unchanged
+	}
+	pattern := c.lang.getLocalizedPattern(format)
+	if pattern == nil {
+		return value, unchanged
+	}
+	indexes := pattern.re.FindStringSubmatchIndex(value)
+	if indexes == nil {
+		return value, unchanged
+	}
+	groups := pattern.groups
+
+	var b strings.Builder
+	last := 0
+	// the start and end offsets of each name in the original and converted values
+	spans := make([][4]int, 0, len(groups))
+	for i, names := range groups {
+		start, end := indexes[2*i+2], indexes[2*i+3]
+		b.WriteString(value[last:start])
+		from := b.Len()
+		b.WriteString(names[strings.ToLower(value[start:end])])
+		spans = append(spans, [4]int{start, end, from, b.Len()})
+		last = end
+	}
+	b.WriteString(value[last:])
+	return b.String(), func(offset int) int {
+		shift := 0
+		for _, span := range spans {
+			if offset < span[2] {
+				break
+			}
+			if offset < span[3] {
+				return span[0]
+			}
+			shift = span[1] - span[3]
+		}
+		return offset + shift
+	}
+}
+
+// localized pattern of a format which matches the localized names by the symbols of the format
+// 格式模板的本地化正则，根据格式模板中的符号匹配本地化名称
+type localizedPattern struct {
+	re     *regexp.Regexp
+	groups []map[string]string
+}
+
+// builds the localized pattern of the format from the resources, it returns nil if the pattern cannot be compiled.
+// 从资源构建格式模板的本地化正则，无法编译时返回 nil
+func newLocalizedPattern(resources map[string]string, format string) *localizedPattern {
+	// the localized names of each symbol are matched in position, such as "Mar" is a short month in "M" but a short weekday in "D"
+	pattern := strings.Builder{}
+	pattern.WriteString("(?i)^")
+	groups := make([]map[string]string, 0)
+	group := func(key string, english func(i int) string, count int, extra ...string) {
+		names := make(map[string]string)
+		if slice := strings.Split(resources[key], "|"); len(slice) == count {
+			for i, name := range slice {
+				names[strings.ToLower(name)] = english(i)
+			}
+		}
+		for i := 0; i+1 < len(extra); i += 2 {
+			names[strings.ToLower(extra[i])] = extra[i+1]
+		}
+		// the longest name is preferred, such as "十一月" rather than "一月"
+		alternatives := make([]string, 0, len(names))
+		for name := range names {
+			alternatives = append(alternatives, name)
+		}
+		sort.Slice(alternatives, func(i, j int) bool {
+			if len(alternatives[i]) != len(alternatives[j]) {
+				return len(alternatives[i]) > len(alternatives[j])
+			}
+			return alternatives[i] < alternatives[j]
+		})
+		for i, alternative := range alternatives {
+			alternatives[i] = regexp.QuoteMeta(alternative)
+		}
+		pattern.WriteString("(" + strings.Join(alternatives, "|") + ")")
+		groups = append(groups, names)
+	}
+	for i := 0; i < len(format); i++ {
+		switch symbol := format[i]; {
+		case symbol == '\\' && i+1 < len(format):
+			i++
+			pattern.WriteString(regexp.QuoteMeta(format[i : i+1]))
+		case symbol == 'F':
+			group("months", func(i int) string { return time.Month(i + 1).String() }, MonthsPerYear)
+		case symbol == 'M':
+			group("short_months", func(i int) string { return time.Month(i + 1).String()[:3] }, MonthsPerYear)
+		case symbol == 'l':
+			group("weeks", func(i int) string { return time.Weekday(i).String() }, DaysPerWeek)
+		case symbol == 'D':
+			group("short_weeks", func(i int) string { return time.Weekday(i).String()[:3] }, DaysPerWeek)
+		case symbol == 'a':
+			group("meridiems", func(i int) string { return []string{"am", "pm"}[i] }, 2, "am", "am", "pm", "pm")
+		case symbol == 'A':
+			group("meridiems", func(i int) string { return []string{"AM", "PM"}[i] }, 2, "am", "AM", "pm", "PM")
+		case formats[symbol] != "":
+			pattern.WriteString(".*?")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(format[i : i+1]))
+		}
+	}
+	pattern.WriteString("$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil
+	}
+	return &localizedPattern{re: re, groups: groups}
+}
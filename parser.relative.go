@@ -0,0 +1,554 @@
+package carbon
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// max absolute amount of each relative unit like "3 days", the larger one is rejected to avoid overflowing
+// 每种相对时间单位的最大绝对数量，更大的数量会被拒绝以免溢出
+const maxRelativeAmount = 1000000
+
+var (
+	// relative number pattern like "+3" or "3days"
+	// 相对数字正则
+	relativeNumberPattern = regexp.MustCompile(`^([+-]?\d+)([a-z]*)$`)
+
+	// relative time pattern like "15:04", "15:04:05", "3pm" or "3:04pm"
+	// 相对时间正则
+	relativeTimePattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2}))?(am|pm)?$`)
+
+	// localized relative pattern, the number is surrounded by the words like "vor3tagen"
+	// 本地化相对时间正则
+	localizedRelativePattern = regexp.MustCompile(`^(\D*)(\d+)(\D*)$`)
+
+	// relative moves
+	// 相对移动方向
+	relativeMoves = map[string]int{"next": 1, "last": -1, "previous": -1, "this": 0}
+
+	// relative ordinals
+	// 相对序数词
+	relativeOrdinals = map[string]int{
+		"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5, "sixth": 6,
+		"seventh": 7, "eighth": 8, "ninth": 9, "tenth": 10, "eleventh": 11, "twelfth": 12, "last": -1,
+	}
+
+	// relative units which are keyed by the aliases
+	// 按别名存储的相对单位
+	relativeUnits = map[string]string{
+		"sec": "second", "secs": "second", "second": "second", "seconds": "second",
+		"min": "minute", "mins": "minute", "minute": "minute", "minutes": "minute",
+		"hour": "hour", "hours": "hour",
+		"day": "day", "days": "day",
+		"week": "week", "weeks": "week",
+		"fortnight": "fortnight", "fortnights": "fortnight",
+		"month": "month", "months": "month",
+		"year": "year", "years": "year",
+	}
+
+	// the localized directions of the relative expressions in lang/*.json
+	// 本地化相对时间的方向
+	localizedRelativeDirections = []struct {
+		key  string
+		sign int
+	}{{"ago", -1}, {"from_now", 1}, {"before", -1}, {"after", 1}}
+
+	// the localized units of the relative expressions in lang/*.json
+	// 本地化相对时间的单位
+	localizedRelativeUnits = []string{"year", "month", "week", "day", "hour", "minute", "second"}
+
+	// the localized relative rules of all embedded languages, they are built only once
+	// 所有内嵌语言的本地化相对时间规则，只构建一次
+	embeddedRelativeRules = struct {
+		once  sync.Once
+		rules []*localizedRelativeRules
+	}{}
+)
+
+// localized relative rules which are normalized from the resources of a language
+// 从语言资源规范化得到的本地化相对时间规则
+type localizedRelativeRules struct {
+	now        string
+	directions []localizedRelativeDirection
+	forms      []localizedRelativeForm
+}
+
+// localized direction like "%s ago", the template is split into the prefix and the suffix
+// 本地化方向，模板被拆分为前缀和后缀
+type localizedRelativeDirection struct {
+	prefix, suffix string
+	sign           int
+}
+
+// localized form of a unit like "%d Tagen" or the literal "bir gün"
+// 单位的本地化形式
+type localizedRelativeForm struct {
+	unit                 string
+	position             int
+	literal              string
+	hasNumber            bool
+	numPrefix, numSuffix string
+}
+
+// relative expression like "last day of next month"
+// 相对时间表达式
+type relativeExpression struct {
+	tokens                  []string
+	index                   int
+	years, months, days     int
+	hours, minutes, seconds int
+	year, month             int
+	hour, minute, second    int
+	hasTime, resetTime      bool
+	hasUnit                 bool
+	dayOf                   int
+	nth                     int
+	nthWeekday, weekday     time.Weekday
+	hasWeekday              bool
+	weekdayMove             int
+}
+
+// parses a strtotime-style relative expression like "+3 days", "next monday", "last day of next month",
+// "first friday of january 2025", "noon tomorrow", "3 weeks ago" or a localized one like "3天前".
+// 解析 strtotime 风格的相对时间表达式
+func (c Carbon) parseRelative(value string) (Carbon, bool) {
+	now := c.Now()
+	now.Error = nil
+	if now.IsInvalid() {
+		return c, false
+	}
+	e := &relativeExpression{tokens: strings.Fields(strings.ToLower(strings.ReplaceAll(value, ",", " ")))}
+	if len(e.tokens) > 0 && e.parse() {
+		c.time = e.apply(now.ToStdTime(), c.loc)
+		return c, true
+	}
+	if t, ok := c.parseLocalizedRelative(value, now.ToStdTime()); ok {
+		c.time = t
+		return c, true
+	}
+	return c, false
+}
+
+// parses all tokens of the expression.
+// 解析所有词元
+func (e *relativeExpression) parse() bool {
+	for e.index < len(e.tokens) {
+		if !e.parseToken() {
+			return false
+		}
+	}
+	return true
+}
+
+// parses the next token, the following tokens are consumed if they belong to the same phrase.
+// 解析下一个词元，属于同一短语的后续词元也会被消费
+func (e *relativeExpression) parseToken() bool {
+	token := e.next()
+	switch token {
+	case "now":
+		return true
+	case "at", "and":
+		// the connectors are only accepted between two other tokens
+		return e.index > 1 && e.index < len(e.tokens) && !isRelativeConnector(e.tokens[e.index-2]) && !isRelativeConnector(e.peek(0))
+	case "today", "midnight":
+		e.resetTime = true
+		return true
+	case "noon":
+		e.setTime(12, 0, 0)
+		return true
+	case "tomorrow":
+		e.days, e.resetTime = e.days+1, true
+		return true
+	case "yesterday":
+		e.days, e.resetTime = e.days-1, true
+		return true
+	case "ago":
+		// "ago" is only accepted once as the last token after some units
+		if !e.hasUnit || e.index != len(e.tokens) {
+			return false
+		}
+		e.years, e.months, e.days = -e.years, -e.months, -e.days
+		e.hours, e.minutes, e.seconds = -e.hours, -e.minutes, -e.seconds
+		return true
+	case "a", "an":
+		if unit, ok := relativeUnits[e.peek(0)]; ok {
+			e.index++
+			return e.addUnit(1, unit)
+		}
+		return false
+	}
+
+	// phrases like "first day of", "last friday of" and "second monday of"
+	if nth, ok := relativeOrdinals[token]; ok {
+		if e.peek(0) == "day" && e.peek(1) == "of" && (nth == 1 || nth == -1) {
+			e.index += 2
+			e.dayOf = nth
+			return true
+		}
+		if weekday, ok := getRelativeWeekday(e.peek(0)); ok && e.peek(1) == "of" {
+			e.index += 2
+			e.nth, e.nthWeekday, e.resetTime = nth, weekday, true
+			return true
+		}
+	}
+
+	// phrases like "next week", "last monday" and "this month"
+	if move, ok := relativeMoves[token]; ok {
+		if weekday, ok := getRelativeWeekday(e.peek(0)); ok {
+			e.index++
+			e.weekday, e.weekdayMove, e.hasWeekday, e.resetTime = weekday, move, true, true
+			return true
+		}
+		if unit, ok := relativeUnits[e.peek(0)]; ok {
+			e.index++
+			return e.addUnit(move, unit)
+		}
+		return false
+	}
+
+	if weekday, ok := getRelativeWeekday(token); ok {
+		e.weekday, e.weekdayMove, e.hasWeekday, e.resetTime = weekday, 0, true, true
+		return true
+	}
+
+	// month name with an optional year like "january 2025"
+	if month, ok := getRelativeMonth(token); ok {
+		e.month = month
+		if year := e.peek(0); len(year) == 4 {
+			if n, err := strconv.Atoi(year); err == nil {
+				e.index++
+				e.year = n
+			}
+		}
+		return true
+	}
+
+	// time like "15:04", "3pm" or "3 pm"
+	if matches := relativeTimePattern.FindStringSubmatch(token); matches != nil {
+		meridiem := matches[4]
+		if meridiem == "" && (e.peek(0) == "am" || e.peek(0) == "pm") {
+			meridiem = e.next()
+		}
+		if meridiem != "" || matches[2] != "" {
+			return e.parseTime(matches[1], matches[2], matches[3], meridiem)
+		}
+	}
+
+	// number with an attached or a following unit like "+3 days" or "3days"
+	if matches := relativeNumberPattern.FindStringSubmatch(token); matches != nil {
+		n, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return false
+		}
+		name := matches[2]
+		if name == "" {
+			name = e.next()
+		}
+		if unit, ok := relativeUnits[name]; ok {
+			return e.addUnit(n, unit)
+		}
+	}
+	return false
+}
+
+// parses the time, the hour is in 12-hour format if the meridiem is given.
+// 解析时间，如果有上下午标识则小时为 12 小时制
+func (e *relativeExpression) parseTime(hour, minute, second, meridiem string) bool {
+	h, _ := strconv.Atoi(hour)
+	m, _ := strconv.Atoi(minute)
+	s, _ := strconv.Atoi(second)
+	if meridiem != "" {
+		if h < 1 || h > 12 {
+			return false
+		}
+		h %= 12
+		if meridiem == "pm" {
+			h += 12
+		}
+	}
+	if h > 23 || m > 59 || s > 59 {
+		return false
+	}
+	e.setTime(h, m, s)
+	return true
+}
+
+// sets the time.
+// 设置时间
+func (e *relativeExpression) setTime(hour, minute, second int) {
+	e.hour, e.minute, e.second, e.hasTime = hour, minute, second, true
+}
+
+// adds some units, it reports false if the amount is out of range.
+// 增加若干单位，数量超出范围时返回 false
+func (e *relativeExpression) addUnit(n int, unit string) bool {
+	if n > maxRelativeAmount || n < -maxRelativeAmount {
+		return false
+	}
+	var amount *int
+	switch unit {
+	case "year":
+		amount = &e.years
+	case "month":
+		amount = &e.months
+	case "fortnight":
+		amount, n = &e.days, n*2*DaysPerWeek
+	case "week":
+		amount, n = &e.days, n*DaysPerWeek
+	case "day":
+		amount = &e.days
+	case "hour":
+		amount = &e.hours
+	case "minute":
+		amount = &e.minutes
+	default:
+		amount = &e.seconds
+	}
+	*amount += n
+	e.hasUnit = true
+	return *amount <= maxRelativeAmount && *amount >= -maxRelativeAmount
+}
+
+// returns the next token.
+// 返回下一个词元
+func (e *relativeExpression) next() string {
+	token := e.peek(0)
+	e.index++
+	return token
+}
+
+// reports whether the token is a connector like "at" or "and".
+// 是否是 "at" 或 "and" 之类的连接词
+func isRelativeConnector(token string) bool {
+	return token == "at" || token == "and"
+}
+
+// returns the following token without consuming it.
+// 返回后续词元但不消费
+func (e *relativeExpression) peek(offset int) string {
+	if e.index+offset < len(e.tokens) {
+		return e.tokens[e.index+offset]
+	}
+	return ""
+}
+
+// applies the expression to now, the absolute month and year are set first,
+// then the relative date, the weekday and the time are applied in order.
+// 将表达式应用于当前时间，依次设置年月、相对日期、星期和时间
+func (e *relativeExpression) apply(now time.Time, loc *time.Location) time.Time {
+	year, month, day := now.Year(), int(now.Month()), now.Day()
+	if e.year > 0 {
+		year = e.year
+	}
+	if e.month > 0 {
+		month = e.month
+	}
+	year, month = year+e.years, month+e.months
+	switch {
+	case e.nth > 0:
+		weekday := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC).Weekday()
+		day = 1 + (int(e.nthWeekday)-int(weekday)+DaysPerWeek)%DaysPerWeek + (e.nth-1)*DaysPerWeek
+	case e.nth < 0:
+		last := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC)
+		day = last.Day() - (int(last.Weekday())-int(e.nthWeekday)+DaysPerWeek)%DaysPerWeek
+	case e.dayOf > 0:
+		day = 1
+	case e.dayOf < 0:
+		day = time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	}
+	date := time.Date(year, time.Month(month), day+e.days, 0, 0, 0, 0, time.UTC)
+	if e.hasWeekday {
+		diff := (int(e.weekday) - int(date.Weekday()) + DaysPerWeek) % DaysPerWeek
+		switch {
+		case e.weekdayMove > 0 && diff == 0:
+			diff = DaysPerWeek
+		case e.weekdayMove < 0:
+			diff -= DaysPerWeek
+		}
+		date = date.AddDate(0, 0, diff)
+	}
+	hour, minute, second, nanosecond := now.Hour(), now.Minute(), now.Second(), now.Nanosecond()
+	switch {
+	case e.hasTime:
+		hour, minute, second, nanosecond = e.hour, e.minute, e.second, 0
+	case e.resetTime:
+		hour, minute, second, nanosecond = 0, 0, 0, 0
+	}
+	wall := time.Date(date.Year(), date.Month(), date.Day(), hour, minute, second, nanosecond, time.UTC)
+	duration := time.Duration(e.hours)*time.Hour + time.Duration(e.minutes)*time.Minute + time.Duration(e.seconds)*time.Second
+	return getWallClockTime(wall, loc).Add(duration)
+}
+
+// parses a localized relative expression like "3天前" or "vor 3 Tagen" by the words in lang/*.json,
+// the language of the current instance is preferred.
+// 根据 lang/*.json 中的词语解析本地化相对时间表达式，优先使用当前实例的语言
+func (c Carbon) parseLocalizedRelative(value string, now time.Time) (time.Time, bool) {
+	value = normalizeLocalizedWord(value)
+	if value == "" {
+		return now, false
+	}
+	rules := make([]*localizedRelativeRules, 0)
+	if c.lang != nil {
+		if rule := c.lang.getRelativeRules(); rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+	embeddedRelativeRules.once.Do(loadEmbeddedRelativeRules)
+	rules = append(rules, embeddedRelativeRules.rules...)
+
+	for _, rule := range rules {
+		if rule.now != "" && rule.now == value {
+			return now, true
+		}
+		for _, direction := range rule.directions {
+			if len(value) <= len(direction.prefix)+len(direction.suffix) || !strings.HasPrefix(value, direction.prefix) || !strings.HasSuffix(value, direction.suffix) {
+				continue
+			}
+			// the direction is only accepted once like "3天前" but not "3天前前"
+			amount := value[len(direction.prefix) : len(value)-len(direction.suffix)]
+			if (direction.prefix != "" && strings.HasPrefix(amount, direction.prefix)) || (direction.suffix != "" && strings.HasSuffix(amount, direction.suffix)) {
+				continue
+			}
+			if n, unit, ok := rule.matchUnit(amount); ok {
+				return addLocalizedRelativeUnit(now, n*direction.sign, unit), true
+			}
+		}
+	}
+	return now, false
+}
+
+// builds the localized relative rules from the resources of a language.
+// 从语言资源构建本地化相对时间规则
+func newLocalizedRelativeRules(resources map[string]string) *localizedRelativeRules {
+	rules := &localizedRelativeRules{now: normalizeLocalizedWord(resources["now"])}
+	for _, direction := range localizedRelativeDirections {
+		template := normalizeLocalizedWord(resources[direction.key])
+		if i := strings.Index(template, "%s"); i >= 0 {
+			rules.directions = append(rules.directions, localizedRelativeDirection{prefix: template[:i], suffix: template[i+2:], sign: direction.sign})
+		}
+	}
+	for _, unit := range localizedRelativeUnits {
+		for i, word := range strings.Split(resources[unit], "|") {
+			word = normalizeLocalizedWord(word)
+			form := localizedRelativeForm{unit: unit, position: i + 1, literal: word}
+			if parts := localizedRelativePattern.FindStringSubmatch(strings.Replace(word, "%d", "0", 1)); parts != nil && parts[3] != "" {
+				form.hasNumber, form.numPrefix, form.numSuffix = true, parts[1], parts[3]
+			}
+			if strings.Contains(word, "%d") {
+				form.literal = ""
+			}
+			rules.forms = append(rules.forms, form)
+		}
+	}
+	return rules
+}
+
+// matches the localized amount of the unit like "3tagen", the unit word may be inflected like "Tagen" of "Tage",
+// the literal forms without number like "bir gün" mean the amount of their positions.
+// 匹配本地化的单位数量，单位词语可以有词形变化，没有数字的字面形式表示其位置对应的数量
+func (rules *localizedRelativeRules) matchUnit(value string) (n int, unit string, ok bool) {
+	matches := localizedRelativePattern.FindStringSubmatch(value)
+	best := 0
+	for _, form := range rules.forms {
+		if matches == nil {
+			if form.literal != "" && form.literal == value {
+				return form.position, form.unit, true
+			}
+			continue
+		}
+		if !form.hasNumber || form.numPrefix != matches[1] || !strings.HasPrefix(matches[3], form.numSuffix) {
+			continue
+		}
+		// the exact word is preferred, then the longest one
+		score := len(form.numSuffix) * 2
+		if form.numSuffix == matches[3] {
+			score++
+		}
+		if score > best {
+			best, unit = score, form.unit
+		}
+	}
+	if best == 0 {
+		return 0, "", false
+	}
+	n, err := strconv.Atoi(matches[2])
+	return n, unit, err == nil && n <= maxRelativeAmount
+}
+
+// normalizes the localized word by lowering the case and removing the spaces.
+// 规范化本地化词语，转为小写并移除空白
+func normalizeLocalizedWord(word string) string {
+	return strings.Join(strings.Fields(strings.ToLower(word)), "")
+}
+
+// adds the amount of the localized unit.
+// 增加本地化单位的数量
+func addLocalizedRelativeUnit(t time.Time, n int, unit string) time.Time {
+	switch unit {
+	case "year":
+		return t.AddDate(n, 0, 0)
+	case "month":
+		return t.AddDate(0, n, 0)
+	case "week":
+		return t.AddDate(0, 0, n*DaysPerWeek)
+	case "day":
+		return t.AddDate(0, 0, n)
+	case "hour":
+		return t.Add(time.Duration(n) * time.Hour)
+	case "minute":
+		return t.Add(time.Duration(n) * time.Minute)
+	}
+	return t.Add(time.Duration(n) * time.Second)
+}
+
+// builds the localized relative rules of all embedded languages in the order of file names.
+// 按文件名顺序构建所有内嵌语言的本地化相对时间规则
+func loadEmbeddedRelativeRules() {
+	entries, _ := fs.ReadDir(strings.TrimSuffix(defaultDir, "/"))
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resources := make(map[string]string)
+		if bytes, err := fs.ReadFile(defaultDir + name); err == nil && json.Unmarshal(bytes, &resources) == nil {
+			embeddedRelativeRules.rules = append(embeddedRelativeRules.rules, newLocalizedRelativeRules(resources))
+		}
+	}
+}
+
+// gets the weekday by the English name like "monday" or "mon".
+// 通过英文名称获取星期
+func getRelativeWeekday(name string) (time.Weekday, bool) {
+	if len(name) < 3 {
+		return 0, false
+	}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		full := strings.ToLower(weekday.String())
+		if name == full || name == full[:3] {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
+
+// gets the month by the English name like "january" or "jan".
+// 通过英文名称获取月份
+func getRelativeMonth(name string) (int, bool) {
+	if len(name) < 3 {
+		return 0, false
+	}
+	for month := time.January; month <= time.December; month++ {
+		full := strings.ToLower(month.String())
+		if name == full || name == full[:3] {
+			return int(month), true
+		}
+	}
+	return 0, false
+}
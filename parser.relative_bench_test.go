@@ -0,0 +1,17 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_ParseRelative(b *testing.B) {
+	c := NewCarbon()
+	for n := 0; n < b.N; n++ {
+		c.Parse("last day of next month")
+	}
+}
+
+func BenchmarkCarbon_ParseLocalizedRelative(b *testing.B) {
+	c := NewCarbon()
+	for n := 0; n < b.N; n++ {
+		c.Parse("vor 3 Tagen")
+	}
+}
@@ -0,0 +1,117 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarbon_ParseRelative(t *testing.T) {
+	assert := assert.New(t)
+
+	c := NewCarbon()
+	c.SetTestNow(Parse("2020-08-05 13:14:15", PRC))
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0:  {"+3 days", "2020-08-08 13:14:15"},
+		1:  {"-3 days", "2020-08-02 13:14:15"},
+		2:  {"3days", "2020-08-08 13:14:15"},
+		3:  {"+1 week 2 days 4 hours 2 seconds", "2020-08-14 17:14:17"},
+		4:  {"3 weeks ago", "2020-07-15 13:14:15"},
+		5:  {"1 year 2 months ago", "2019-06-05 13:14:15"},
+		6:  {"a fortnight ago", "2020-07-22 13:14:15"},
+		7:  {"next monday", "2020-08-10 00:00:00"},
+		8:  {"last monday", "2020-08-03 00:00:00"},
+		9:  {"next wednesday", "2020-08-12 00:00:00"},
+		10: {"last wednesday", "2020-07-29 00:00:00"},
+		11: {"this wednesday", "2020-08-05 00:00:00"},
+		12: {"friday", "2020-08-07 00:00:00"},
+		13: {"next week", "2020-08-12 13:14:15"},
+		14: {"last year", "2019-08-05 13:14:15"},
+		15: {"this month", "2020-08-05 13:14:15"},
+		16: {"first day of next month", "2020-09-01 13:14:15"},
+		17: {"last day of next month", "2020-09-30 13:14:15"},
+		18: {"last day of february", "2020-02-29 13:14:15"},
+		19: {"first day of this month midnight", "2020-08-01 00:00:00"},
+		20: {"first friday of january 2025", "2025-01-03 00:00:00"},
+		21: {"second monday of next month", "2020-09-14 00:00:00"},
+		22: {"last friday of next month", "2020-09-25 00:00:00"},
+		23: {"last sunday of march 2024", "2024-03-31 00:00:00"},
+		24: {"noon tomorrow", "2020-08-06 12:00:00"},
+		25: {"tomorrow noon", "2020-08-06 12:00:00"},
+		26: {"yesterday 3pm", "2020-08-04 15:00:00"},
+		27: {"tomorrow at 9:30 am", "2020-08-06 09:30:00"},
+		28: {"today 23:59:59", "2020-08-05 23:59:59"},
+		29: {"midnight", "2020-08-05 00:00:00"},
+		30: {"12am", "2020-08-05 00:00:00"},
+		31: {"Next Monday", "2020-08-10 00:00:00"},
+		32: {"+1 month", "2020-09-05 13:14:15"},
+		33: {"jan 2021", "2021-01-05 13:14:15"},
+		34: {"now", "2020-08-05 13:14:15"},
+		35: {"3天前", "2020-08-02 13:14:15"},
+		36: {"2 个月后", "2020-10-05 13:14:15"},
+		37: {"vor 3 Tagen", "2020-08-02 13:14:15"},
+		38: {"vor 1 Stunde", "2020-08-05 12:14:15"},
+		39: {"il y a 2 semaines", "2020-07-22 13:14:15"},
+		40: {"hace 5 minutos", "2020-08-05 13:09:15"},
+		41: {"через 2 дня", "2020-08-07 13:14:15"},
+		42: {"bir gün evvel", "2020-08-04 13:14:15"},
+		43: {"3 日後", "2020-08-08 13:14:15"},
+		44: {"刚刚", "2020-08-05 13:14:15"},
+		45: {"1 day and 2 hours", "2020-08-06 15:14:15"},
+		46: {"1000000 seconds ago", "2020-07-24 23:27:35"},
+	}
+
+	for index, test := range tests {
+		p := c.Parse(test.input)
+		assert.Nil(p.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, p.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_ParseRelativeWithTimezone(t *testing.T) {
+	assert := assert.New(t)
+
+	c := NewCarbon()
+	c.SetTestNow(Parse("2024-03-09 02:30:00", NewYork))
+	assert.Equal("2024-03-10T03:30:00-04:00", c.Parse("+1 day", NewYork).ToRfc3339String())
+	assert.Equal("2024-03-10T07:30:00Z", c.Parse("+1 day", UTC).ToRfc3339String())
+	assert.Equal("2024-03-09T03:30:00-05:00", c.Parse("+1 hour", NewYork).ToRfc3339String())
+	assert.Equal("2024-03-10T00:00:00-05:00", c.Parse("tomorrow midnight", NewYork).ToRfc3339String())
+}
+
+func TestError_ParseRelative(t *testing.T) {
+	c := NewCarbon()
+	c.SetTestNow(Parse("2020-08-05 13:14:15", PRC))
+
+	values := []string{"xxx", "+3", "3 xxx", "next", "next xxx", "first", "first day", "third day of", "13pm", "25:00", "10:60", "a xxx", "ago xxx", "3 xxx ago", "vor Tagen", "xxx前", "at", "and", "at and", "tomorrow at", "and tomorrow", "tomorrow at and noon",
+		"9999999999999999999 days", "1000001 days", "-1000001 years", "100000 fortnights", "600000 hours 600000 hours",
+		"1 day ago ago", "ago 1 day ago", "now ago", "tomorrow ago", "1 day ago tomorrow", "99999999999999天前"}
+	for index, value := range values {
+		assert.NotNil(t, c.Parse(value).Error, "It should catch an exception in Parse(), current test index is "+strconv.Itoa(index))
+	}
+	assert.NotNil(t, c.Parse("+3 days", "xxx").Error, "It should catch an exception in Parse()")
+}
+
+func TestCarbon_ParseLocalizedRelativeWithResources(t *testing.T) {
+	assert := assert.New(t)
+
+	lang := NewLanguage()
+	lang.SetResources(map[string]string{
+		"day": "%d jour|%d jours",
+		"ago": "il y a %s",
+	})
+	c := SetLanguage(lang)
+	c.SetTestNow(Parse("2020-08-05 13:14:15", PRC))
+	assert.Equal("2020-08-02 13:14:15", c.Parse("il y a 3 jours").ToDateTimeString())
+
+	lang.SetResources(map[string]string{
+		"ago": "%s zuvor",
+	})
+	assert.Equal("2020-08-02 13:14:15", c.Parse("3 jours zuvor").ToDateTimeString())
+	assert.NotNil(c.Parse("3 jours il y a").Error)
+}
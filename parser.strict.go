@@ -0,0 +1,240 @@
+package carbon
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fractional seconds in a time string, such as ".999" or ",999"
+// 时间字符串中的小数秒
+var fractionalSecondPattern = regexp.MustCompile(`[.,][0-9]+`)
+
+// ParseStrict parses a standard time string as a Carbon instance strictly, the error is a *ParseError.
+// 严格地将标准格式时间字符串解析成 Carbon 实例，错误为 *ParseError
+func (c Carbon) ParseStrict(value string, timezone ...string) Carbon {
+	carbon := c.SetStrict(true).Parse(value, timezone...)
+	carbon.strict = c.strict
+	return carbon
+}
+
+// ParseStrict parses a standard time string as a Carbon instance strictly, the error is a *ParseError.
+// 严格地将标准格式时间字符串解析成 Carbon 实例，错误为 *ParseError
+func ParseStrict(value string, timezone ...string) Carbon {
+	return NewCarbon().ParseStrict(value, timezone...)
+}
+
+// ParseByFormatStrict parses a time string as a Carbon instance by format strictly, the error is a *ParseError.
+// 通过格式模板严格地将时间字符串解析成 Carbon 实例，错误为 *ParseError
+func (c Carbon) ParseByFormatStrict(value, format string, timezone ...string) Carbon {
+	carbon := c.SetStrict(true).ParseByFormat(value, format, timezone...)
+	carbon.strict = c.strict
+	return carbon
+}
+
+// ParseByFormatStrict parses a time string as a Carbon instance by format strictly, the error is a *ParseError.
+// 通过格式模板严格地将时间字符串解析成 Carbon 实例，错误为 *ParseError
+func ParseByFormatStrict(value, format string, timezone ...string) Carbon {
+	return NewCarbon().ParseByFormatStrict(value, format, timezone...)
+}
+
+// ParseByLayoutStrict parses a time string as a Carbon instance by layout strictly, the error is a *ParseError.
+// 通过布局模板严格地将时间字符串解析成 Carbon 实例，错误为 *ParseError
+func (c Carbon) ParseByLayoutStrict(value, layout string, timezone ...string) Carbon {
+	carbon := c.SetStrict(true).ParseByLayout(value, layout, timezone...)
+	carbon.strict = c.strict
+	return carbon
+}
+
+// ParseByLayoutStrict parses a time string as a Carbon instance by layout strictly, the error is a *ParseError.
+// 通过布局模板严格地将时间字符串解析成 Carbon 实例，错误为 *ParseError
+func ParseByLayoutStrict(value, layout string, timezone ...string) Carbon {
+	return NewCarbon().ParseByLayoutStrict(value, layout, timezone...)
+}
+
+// parses the value by the layout in the location, the error is a *ParseError in strict mode,
+// which also rejects the fractional seconds that Go accepts after the seconds even if they are not in the layout.
+// 通过布局模板解析时间字符串，严格模式下错误为 *ParseError，并拒绝布局模板中没有的小数秒
+func (c Carbon) parseInLayout(value, layout string) (time.Time, error) {
+	t, err := time.ParseInLocation(layout, value, c.loc)
+	if !c.strict {
+		return t, err
+	}
+	if err != nil {
+		return time.Time{}, newParseError(value, layout, err)
+	}
+	if t.Nanosecond() != 0 && !hasFractionalSecond(layout) {
+		return time.Time{}, &ParseError{
+			Value:    value,
+			Layout:   layout,
+			Offset:   getFractionalSecondOffset(value, layout, t),
+			Expected: "end of second",
+			Message:  "fractional second is not in the layout",
+		}
+	}
+	return t, nil
+}
+
+// gets the error of the layout whose mismatch is the furthest in the value when parsing strictly,
+// the layouts which match the structure of the value are preferred.
+// 严格解析时获取不匹配位置最远的布局模板的错误，优先使用与值结构匹配的布局模板
+func (c Carbon) getFurthestParseError(value string) error {
+	indexes := getShapeLayouts(getLayoutShape(value))
+	if len(indexes) == 0 {
+		indexes = allLayoutIndexes
+	}
+	var furthest *ParseError
+	for _, index := range indexes {
+		_, err := c.parseInLayout(value, layouts[index])
+		if e, ok := err.(*ParseError); ok && (furthest == nil || e.Offset > furthest.Offset) {
+			furthest = e
+		}
+	}
+	if furthest == nil {
+		return invalidValueError(value)
+	}
+	return furthest
+}
+
+// converts the error of the time package to a *ParseError.
+// 将 time 包的错误转换为 *ParseError
+func newParseError(value, layout string, err error) *ParseError {
+	e, ok := err.(*time.ParseError)
+	if !ok {
+		return &ParseError{Value: value, Layout: layout, Expected: "valid value", Message: err.Error()}
+	}
+	offset := len(value) - len(e.ValueElem)
+	switch message := strings.TrimPrefix(e.Message, ": "); {
+	case message == "":
+		return &ParseError{Value: value, Layout: layout, Offset: offset, Expected: getLayoutElement(e.LayoutElem)}
+	case strings.HasPrefix(message, "extra text"):
+		return &ParseError{Value: value, Layout: layout, Offset: offset, Expected: "end of value", Message: "extra text"}
+	case e.LayoutElem != "":
+		// the value element has been consumed when it is out of range
+		expected := getLayoutElement(e.LayoutElem)
+		if sign := strings.LastIndexAny(value[:offset], "+-"); expected == "zone offset" && sign >= 0 {
+			offset = sign
+		} else {
+			for offset > 0 && value[offset-1] >= '0' && value[offset-1] <= '9' {
+				offset--
+			}
+		}
+		return &ParseError{Value: value, Layout: layout, Offset: offset, Expected: expected, Message: message}
+	default:
+		// the day is checked after the whole value has been parsed
+		expected := strings.TrimSuffix(message, " out of range")
+		if strings.HasPrefix(expected, "day-of-year") {
+			expected = "day of year"
+		}
+		return &ParseError{Value: value, Layout: layout, Offset: getInvalidNumberOffset(value, layout), Expected: expected, Message: message}
+	}
+}
+
+// returns the *ParseError of a non-numeric or overflowed timestamp.
+// 返回非数字或溢出时间戳的 *ParseError
+func newTimestampParseError(value, layout string, err error) *ParseError {
+	if e, ok := err.(*strconv.NumError); ok && e.Err == strconv.ErrRange {
+		return &ParseError{Value: value, Layout: layout, Expected: "timestamp", Message: "timestamp out of range"}
+	}
+	offset := 0
+	if value != "" && (value[0] == '+' || value[0] == '-') {
+		offset++
+	}
+	for offset < len(value) && value[offset] >= '0' && value[offset] <= '9' {
+		offset++
+	}
+	return &ParseError{Value: value, Layout: layout, Offset: offset, Expected: "digit"}
+}
+
+// gets the name of the layout element.
+// 获取布局模板元素的名称
+func getLayoutElement(element string) string {
+	switch element {
+	case "":
+		return "end of value"
+	case "January", "Jan":
+		return "month name"
+	case "Monday", "Mon":
+		return "weekday name"
+	case "1", "01":
+		return "month"
+	case "2", "02", "_2":
+		return "day"
+	case "002", "__2":
+		return "day of year"
+	case "15", "3", "03":
+		return "hour"
+	case "4", "04":
+		return "minute"
+	case "5", "05":
+		return "second"
+	case "2006", "06":
+		return "year"
+	case "PM", "pm":
+		return "meridiem"
+	case "MST":
+		return "zone abbreviation"
+	}
+	switch {
+	case strings.HasPrefix(element, "Z07") || strings.HasPrefix(element, "-07"):
+		return "zone offset"
+	case len(element) > 1 && (element[0] == '.' || element[0] == ',') && (element[1] == '0' || element[1] == '9'):
+		return "fractional second"
+	}
+	return strconv.Quote(element)
+}
+
+// reports whether the layout has a fractional second element like ".000" or ",999".
+// 布局模板中是否有小数秒元素
+func hasFractionalSecond(layout string) bool {
+	for i := 0; i+1 < len(layout); i++ {
+		if (layout[i] != '.' && layout[i] != ',') || (layout[i+1] != '0' && layout[i+1] != '9') {
+			continue
+		}
+		j := i + 1
+		for j < len(layout) && layout[j] == layout[i+1] {
+			j++
+		}
+		// the digits must end here like the time package
+		if j == len(layout) || layout[j] < '0' || layout[j] > '9' {
+			return true
+		}
+		i = j - 1
+	}
+	return false
+}
+
+// gets the offset of the fractional second which is not in the layout.
+// 获取布局模板中没有的小数秒的位置
+func getFractionalSecondOffset(value, layout string, t time.Time) int {
+	for _, index := range fractionalSecondPattern.FindAllStringIndex(value, -1) {
+		tt, err := time.ParseInLocation(layout, value[:index[0]]+value[index[1]:], t.Location())
+		if err == nil && tt.Equal(t.Truncate(time.Second)) {
+			return index[0]
+		}
+	}
+	return len(value)
+}
+
+// gets the offset of the number which makes the value invalid, such as the day of "2020-02-30",
+// it is the last number which makes the value valid after being replaced with one.
+// 获取导致时间字符串无效的数字的位置，即替换为一后使时间字符串有效的最后一个数字
+func getInvalidNumberOffset(value, layout string) int {
+	for j := len(value); j > 0; {
+		if value[j-1] < '0' || value[j-1] > '9' {
+			j--
+			continue
+		}
+		i := j
+		for i > 0 && value[i-1] >= '0' && value[i-1] <= '9' {
+			i--
+		}
+		one := strings.Repeat("0", j-i-1) + "1"
+		if _, err := time.Parse(layout, value[:i]+one+value[j:]); err == nil {
+			return i
+		}
+		j = i
+	}
+	return len(value)
+}
@@ -0,0 +1,21 @@
+package carbon
+
+import "testing"
+
+func BenchmarkCarbon_ParseStrict(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		ParseStrict("2020-08-05 13:14:15")
+	}
+}
+
+func BenchmarkCarbon_ParseByFormatStrict(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		ParseByFormatStrict("2020-08-05", "Y-m-d")
+	}
+}
+
+func BenchmarkCarbon_ParseByLayoutStrict(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		ParseByLayoutStrict("2020-08-05", "2006-01-02")
+	}
+}
@@ -0,0 +1,158 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCarbon_ParseStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"2020-08-05", "2020-08-05 00:00:00"},
+		1: {"2020-08-05 13:14:15", "2020-08-05 13:14:15"},
+		2: {"2020-08-05 13:14:15.999", "2020-08-05 13:14:15"},
+		3: {"2020-08-05T13:14:15+08:00", "2020-08-05 13:14:15"},
+		4: {"20200805131415", "2020-08-05 13:14:15"},
+	}
+
+	for index, test := range tests {
+		c := ParseStrict(test.input, PRC)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+		assert.False(c.strict, "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_ParseByLayoutStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		layout   string
+		expected string
+	}{
+		0: {"2020-08-05 13:14:15", "2006-01-02 15:04:05", "2020-08-05 13:14:15"},
+		1: {"2020-08-05 13:14:15.999", "2006-01-02 15:04:05.999", "2020-08-05 13:14:15.999"},
+		2: {"1596604455", "timestamp", "2020-08-05 13:14:15"},
+		3: {"1596604455999", "timestampMilli", "2020-08-05 13:14:15.999"},
+	}
+
+	for index, test := range tests {
+		c := ParseByLayoutStrict(test.input, test.layout, PRC)
+		assert.Nil(c.Error)
+		assert.Equal(test.expected, c.ToDateTimeMilliString(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_SetStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	c := SetStrict(true)
+	assert.True(c.strict)
+	assert.NotNil(c.Parse("next monday").Error)
+	assert.NotNil(c.ParseByLayout("abc", "timestamp").Error)
+	assert.True(c.SetStrict(true).ParseByLayout("1596604455", "timestamp").strict)
+
+	c = c.SetStrict(false)
+	assert.Nil(c.Parse("next monday").Error)
+	assert.Nil(c.ParseByLayout("abc", "timestamp").Error)
+	assert.Equal("1970-01-01 00:00:00", c.ParseByLayout("abc", "timestamp", UTC).ToDateTimeString())
+}
+
+func TestError_ParseStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		layout   string
+		offset   int
+		expected string
+		message  string
+	}{
+		0: {"2020-08-05 13:14:15x", "2006-01-02 15:04:05", 19, "end of value", "extra text"},
+		1: {"2020-13-05", "2006-01-02", 5, "month", "month out of range"},
+		2: {"2020-02-30 10:00:00", "2006-01-02 15:04:05", 8, "day", "day out of range"},
+		3: {"2020-08-05T13:14:15+25:00", "2006-01-02T15:04:05-07:00", 19, "zone offset", "time zone offset hour out of range"},
+	}
+
+	for index, test := range tests {
+		err, ok := ParseStrict(test.input, PRC).Error.(*ParseError)
+		assert.True(ok, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.input, err.Value, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.layout, err.Layout, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.offset, err.Offset, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, err.Expected, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.message, err.Message, "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.NotNil(ParseStrict("next monday", PRC).Error)
+	assert.Equal(`cannot parse string "2020-13-05" as carbon by layout "2006-01-02" at offset 5, expected month: month out of range`, ParseStrict("2020-13-05").Error.Error())
+}
+
+func TestError_ParseByLayoutStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		layout   string
+		offset   int
+		expected string
+		message  string
+	}{
+		0: {"abc", "timestamp", 0, "digit", ""},
+		1: {"12a3", "timestampMilli", 2, "digit", ""},
+		2: {"-", "timestampNano", 1, "digit", ""},
+		3: {"99999999999999999999", "timestampMicro", 0, "timestamp", "timestamp out of range"},
+		4: {"2020-08-05 13:14:15.5", "2006-01-02 15:04:05", 19, "end of second", "fractional second is not in the layout"},
+		5: {"2020-08-05 xx", "2006-01-02 15:04", 11, "hour", ""},
+		6: {"2020/08/05", "2006-01-02", 4, `"-"`, ""},
+		7: {"Wed, 2020", "Monday, 2006", 0, "weekday name", ""},
+		8: {"2020-08-05 13:14:15 08:00", "2006-01-02 15:04:05 MST", 20, "zone abbreviation", ""},
+	}
+
+	for index, test := range tests {
+		err, ok := ParseByLayoutStrict(test.input, test.layout, PRC).Error.(*ParseError)
+		assert.True(ok, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.layout, err.Layout, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.offset, err.Offset, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, err.Expected, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.message, err.Message, "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.NotNil(ParseByLayoutStrict("2020-08-05", "2006-01-02", "xxx").Error)
+}
+
+func TestError_ParseByFormatStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale   string
+		input    string
+		format   string
+		offset   int
+		expected string
+		message  string
+	}{
+		0: {"en", "2020-08-05 25", "Y-m-d H", 11, "hour", "hour out of range"},
+		1: {"zh-CN", "2020年8月5日 星期三 25点", "Y年n月j日 l H点", 26, "hour", "hour out of range"},
+		2: {"zh-CN", "周三 2020年8月5日 25点", "D Y年n月j日 H点", 23, "hour", "hour out of range"},
+		3: {"zh-CN", "周三 2020年13月5日", "D Y年n月j日", 14, "month", "month out of range"},
+	}
+
+	for index, test := range tests {
+		err, ok := SetLocale(test.locale).ParseByFormatStrict(test.input, test.format, PRC).Error.(*ParseError)
+		assert.True(ok, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.input, err.Value, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.offset, err.Offset, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, err.Expected, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.message, err.Message, "Current test index is "+strconv.Itoa(index))
+	}
+
+	assert.NotNil(ParseByFormatStrict("2020-08-05", "Y-m-d", "xxx").Error)
+}
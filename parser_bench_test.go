@@ -8,12 +8,31 @@ func BenchmarkCarbon_Parse(b *testing.B) {
 	}
 }
 
+func BenchmarkCarbon_ParseWithLateLayout(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		Parse("20200805131415.999999999+08:00")
+	}
+}
+
+func BenchmarkCarbon_ParseWithInvalidValue(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		Parse("2020-13-05 13:14:15")
+	}
+}
+
 func BenchmarkCarbon_ParseByFormat(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		ParseByFormat("2020-08-05", "Y-m-d")
 	}
 }
 
+func BenchmarkCarbon_ParseByFormatWithLocale(b *testing.B) {
+	c := SetLocale("zh-CN")
+	for n := 0; n < b.N; n++ {
+		c.ParseByFormat("2020年8月5日 星期三", "Y年n月j日 l")
+	}
+}
+
 func BenchmarkCarbon_ParseByLayout(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		ParseByLayout("2020-08-05", "2006-01-02")
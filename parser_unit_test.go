@@ -2,7 +2,9 @@ package carbon
 
 import (
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -138,6 +140,99 @@ func TestCarbon_ParseByFormat(t *testing.T) {
 	}
 }
 
+func TestCarbon_ParseByFormatWithLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		locale   string
+		input    string
+		format   string
+		expected string
+	}{
+		0:  {"fr", "5 août 2020", "j F Y", "2020-08-05 00:00:00"},
+		1:  {"zh-CN", "2020年8月5日 星期三", "Y年n月j日 l", "2020-08-05 00:00:00"},
+		2:  {"zh-CN", "十一月 5 2020", "F j Y", "2020-11-05 00:00:00"},
+		3:  {"zh-CN", "2020 12月 5", "Y M j", "2020-12-05 00:00:00"},
+		4:  {"de", "Mittwoch, 05. AUGUST 2020 1:14 pm", "l, d. F Y g:i a", "2020-08-05 13:14:00"},
+		5:  {"ru", "ср, 5 авг 2020 1:14 PM", "D, j M Y g:i A", "2020-08-05 13:14:00"},
+		6:  {"jp", "2020年8がつ5日(水)", "Y年Mj日(D)", "2020-08-05 00:00:00"},
+		7:  {"en", "Wed, Aug 5, 2020 1:14 PM", "D, M j, Y g:i a", "2020-08-05 13:14:00"},
+		8:  {"zh-CN", "2020年8月5日 下午1:14", "Y年n月j日 Ag:i", "2020-08-05 13:14:00"},
+		9:  {"jp", "2020年8月5日 午前9:14", "Y年n月j日 Ag:i", "2020-08-05 09:14:00"},
+		10: {"kr", "2020-08-05 오후 1:14", "Y-m-d A g:i", "2020-08-05 13:14:00"},
+	}
+
+	for index, test := range tests {
+		c := SetLocale(test.locale).ParseByFormat(test.input, test.format, PRC)
+		assert.Nil(c.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, c.ToDateTimeString(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	lang := NewLanguage()
+	lang.SetResources(map[string]string{"meridiems": "上午|下午"})
+	c := SetLanguage(lang).ParseByFormat("2020-08-05 1:14 下午", "Y-m-d g:i A", PRC)
+	assert.Nil(c.Error)
+	assert.Equal("2020-08-05 13:14:00", c.ToDateTimeString())
+	assert.Equal("2020-08-05 1:14 PM", c.Format("Y-m-d g:i A"))
+}
+
+func TestCarbon_ParseByFormatRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	entries, _ := fs.ReadDir("lang")
+	format := "l, D, j F Y, M, g:i:s A"
+	for _, entry := range entries {
+		locale := strings.TrimSuffix(entry.Name(), ".json")
+		for month := 1; month <= MonthsPerYear; month++ {
+			c := SetLocale(locale).CreateFromDateTime(2020, month, month+month%7, month, 14, 15, PRC)
+			value := c.Format(format)
+			p := SetLocale(locale).ParseByFormat(value, format, PRC)
+			assert.Nil(p.Error, "Current locale is "+locale+", value is "+value)
+			assert.Equal(c.ToDateTimeString(), p.ToDateTimeString(), "Current locale is "+locale+", value is "+value)
+		}
+	}
+}
+
+func TestCarbon_ParseByLayouts(t *testing.T) {
+	assert := assert.New(t)
+
+	// the layouts of the value shape must give the same result as trying all layouts in order
+	c := NewCarbon()
+	date := time.Date(2020, 8, 5, 13, 14, 15, 999999999, time.FixedZone("", 8*3600))
+	for _, layout := range layouts {
+		values := []string{date.Format(layout), date.AddDate(0, 5, 0).Format(layout), date.Add(-13 * time.Hour).Format(layout)}
+		values = append(values, strings.Replace(values[0], "08", "13", 1), strings.Replace(values[0], "05", "32", 1))
+		for _, value := range values {
+			expected, expectedOk := time.Time{}, false
+			for _, l := range layouts {
+				if t, err := time.ParseInLocation(l, value, c.loc); err == nil {
+					expected, expectedOk = t, true
+					break
+				}
+			}
+			actual, actualOk := c.parseByLayouts(value)
+			assert.Equal(expectedOk, actualOk, "Current value is "+value)
+			assert.Equal(expected, actual, "Current value is "+value)
+		}
+	}
+}
+
+func TestCarbon_ParseByLayoutsWithManyShapes(t *testing.T) {
+	assert := assert.New(t)
+
+	// the unique garbage values must not keep the real shapes from being cached
+	for i := 0; i <= maxLayoutShapes; i++ {
+		assert.NotNil(Parse(strings.Repeat("x", i+1) + "1").Error)
+	}
+	assert.Equal("2020-08-05 13:14:15", Parse("2020-08-05 13:14:15").ToDateTimeString())
+
+	layoutShapes.mu.Lock()
+	defer layoutShapes.mu.Unlock()
+	assert.Equal(maxLayoutShapes, layoutShapes.recent.Len())
+	assert.Equal(maxLayoutShapes, len(layoutShapes.candidates))
+	assert.Contains(layoutShapes.candidates, getLayoutShape("2020-08-05 13:14:15"))
+}
+
 func TestCarbon_ParseByLayout(t *testing.T) {
 	assert := assert.New(t)
 
@@ -203,6 +298,8 @@ func TestError_ParseByLayout(t *testing.T) {
 func TestError_ParseByFormat(t *testing.T) {
 	assert.NotNil(t, ParseByFormat("2020-08-05", "Y-m-d", "xxx").Error, "It should catch an exception in ParseByFormat()")
 	assert.NotNil(t, ParseByFormat("xxx", "Y-m-d", PRC).Error, "It should catch an exception in ParseByFormat")
+	assert.NotNil(t, SetLocale("fr").ParseByFormat("5 xxx 2020", "j F Y", PRC).Error, "It should catch an exception in ParseByFormat")
+	assert.NotNil(t, SetLocale("zh-CN").ParseByFormat("2020年8月5日 周三", "Y年n月j日 l", PRC).Error, "It should catch an exception in ParseByFormat")
 }
 
 // https://github.com/golang-module/carbon/issues/206
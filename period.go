@@ -0,0 +1,298 @@
+package carbon
+
+import (
+	"time"
+)
+
+// Period defines a Period struct.
+// 定义 Period 结构体
+type Period struct {
+	start, end   Carbon
+	step         int
+	traveler     func(c Carbon, step int) Carbon
+	excludeStart bool
+	excludeEnd   bool
+	filters      []func(c Carbon) bool
+	Error        error
+}
+
+// NewPeriod returns a new Period instance from start to end, it steps one day by default and includes both ends.
+// 初始化 Period 结构体，默认步长为1天且包含开始和结束时间
+func NewPeriod(start, end Carbon) Period {
+	p := Period{start: start, end: end}
+	if start.Error != nil {
+		p.Error = start.Error
+		return p
+	}
+	if end.Error != nil {
+		p.Error = end.Error
+		return p
+	}
+	return p.EveryDays(1)
+}
+
+// Period returns a new Period instance from the current Carbon instance to end.
+// 返回从当前时间到结束时间的 Period 实例
+func (c Carbon) Period(end Carbon) Period {
+	return NewPeriod(c, end)
+}
+
+// Start gets start time.
+// 获取开始时间
+func (p Period) Start() Carbon {
+	return p.start
+}
+
+// End gets end time.
+// 获取结束时间
+func (p Period) End() Carbon {
+	return p.end
+}
+
+// EveryYears sets the step to some years.
+// 设置步长为N年
+func (p Period) EveryYears(years int) Period {
+	return p.every(years, Carbon.AddYears)
+}
+
+// EveryYearsNoOverflow sets the step to some years without overflowing month.
+// 设置步长为N年(月份不溢出)
+func (p Period) EveryYearsNoOverflow(years int) Period {
+	return p.every(years, Carbon.AddYearsNoOverflow)
+}
+
+// EveryQuarters sets the step to some quarters.
+// 设置步长为N个季度
+func (p Period) EveryQuarters(quarters int) Period {
+	return p.every(quarters, Carbon.AddQuarters)
+}
+
+// EveryQuartersNoOverflow sets the step to some quarters without overflowing month.
+// 设置步长为N个季度(月份不溢出)
+func (p Period) EveryQuartersNoOverflow(quarters int) Period {
+	return p.every(quarters, Carbon.AddQuartersNoOverflow)
+}
+
+// EveryMonths sets the step to some months.
+// 设置步长为N个月
+func (p Period) EveryMonths(months int) Period {
+	return p.every(months, Carbon.AddMonths)
+}
+
+// EveryMonthsNoOverflow sets the step to some months without overflowing month.
+// 设置步长为N个月(月份不溢出)
+func (p Period) EveryMonthsNoOverflow(months int) Period {
+	return p.every(months, Carbon.AddMonthsNoOverflow)
+}
+
+// EveryWeeks sets the step to some weeks.
+// 设置步长为N周
+func (p Period) EveryWeeks(weeks int) Period {
+	return p.every(weeks, Carbon.AddWeeks)
+}
+
+// EveryDays sets the step to some days.
+// 设置步长为N天
+func (p Period) EveryDays(days int) Period {
+	return p.every(days, Carbon.AddDays)
+}
+
+// EveryHours sets the step to some hours.
+// 设置步长为N小时
+func (p Period) EveryHours(hours int) Period {
+	return p.every(hours, Carbon.AddHours)
+}
+
+// EveryMinutes sets the step to some minutes.
+// 设置步长为N分钟
+func (p Period) EveryMinutes(minutes int) Period {
+	return p.every(minutes, Carbon.AddMinutes)
+}
+
+// EverySeconds sets the step to some seconds.
+// 设置步长为N秒
+func (p Period) EverySeconds(seconds int) Period {
+	return p.every(seconds, Carbon.AddSeconds)
+}
+
+// EveryDuration sets the step to a duration.
+// 设置步长为指定时长
+func (p Period) EveryDuration(duration time.Duration) Period {
+	step := 1
+	if duration < 0 {
+		step, duration = -1, -duration
+	}
+	if duration == 0 {
+		step = 0
+	}
+	return p.every(step, func(c Carbon, step int) Carbon {
+		if c.IsInvalid() {
+			return c
+		}
+		c.time = c.ToStdTime().Add(duration * time.Duration(step))
+		return c
+	})
+}
+
+// sets the step and traveler.
+// 设置步长和步进方法
+func (p Period) every(step int, traveler func(c Carbon, step int) Carbon) Period {
+	if p.Error != nil {
+		return p
+	}
+	if step == 0 {
+		p.Error = invalidStepError(step)
+		return p
+	}
+	p.step, p.traveler = step, traveler
+	return p
+}
+
+// IncludeStart includes the start time.
+// 包含开始时间
+func (p Period) IncludeStart() Period {
+	p.excludeStart = false
+	return p
+}
+
+// ExcludeStart excludes the start time.
+// 不包含开始时间
+func (p Period) ExcludeStart() Period {
+	p.excludeStart = true
+	return p
+}
+
+// IncludeEnd includes the end time.
+// 包含结束时间
+func (p Period) IncludeEnd() Period {
+	p.excludeEnd = false
+	return p
+}
+
+// ExcludeEnd excludes the end time.
+// 不包含结束时间
+func (p Period) ExcludeEnd() Period {
+	p.excludeEnd = true
+	return p
+}
+
+// Filter adds a filter, only the times which the filter returns true for are kept.
+// 添加过滤器，只保留过滤器返回 true 的时间
+func (p Period) Filter(filter func(c Carbon) bool) Period {
+	filters := make([]func(c Carbon) bool, len(p.filters), len(p.filters)+1)
+	copy(filters, p.filters)
+	p.filters = append(filters, filter)
+	return p
+}
+
+// Weekdays keeps weekdays only.
+// 只保留工作日
+func (p Period) Weekdays() Period {
+	return p.Filter(Carbon.IsWeekday)
+}
+
+// Weekends keeps weekends only.
+// 只保留周末
+func (p Period) Weekends() Period {
+	return p.Filter(Carbon.IsWeekend)
+}
+
+// Iterator returns a new PeriodIterator instance.
+// 返回迭代器
+func (p Period) Iterator() *PeriodIterator {
+	return &PeriodIterator{period: p}
+}
+
+// ForEach calls the function for each time of the period until the function returns false.
+// 遍历每个时间，函数返回 false 时停止
+func (p Period) ForEach(fn func(c Carbon) bool) {
+	it := p.Iterator()
+	for it.Next() {
+		if !fn(it.Current()) {
+			return
+		}
+	}
+}
+
+// ToSlice outputs all times of the period as a slice.
+// 输出所有时间切片
+func (p Period) ToSlice() []Carbon {
+	slice := make([]Carbon, 0)
+	p.ForEach(func(c Carbon) bool {
+		slice = append(slice, c)
+		return true
+	})
+	return slice
+}
+
+// Count gets the number of times of the period.
+// 获取时间个数
+func (p Period) Count() int {
+	count := 0
+	p.ForEach(func(c Carbon) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// PeriodIterator defines a PeriodIterator struct.
+// 定义 PeriodIterator 结构体
+type PeriodIterator struct {
+	period  Period
+	index   int
+	current Carbon
+	isDone  bool
+}
+
+// Next advances the iterator to the next time, it reports whether there is a next time.
+// 前进到下一个时间，返回是否存在下一个时间
+func (it *PeriodIterator) Next() bool {
+	p := it.period
+	if it.isDone || p.Error != nil || p.traveler == nil || p.start.IsInvalid() || p.end.IsInvalid() {
+		it.isDone = true
+		return false
+	}
+	for {
+		// always travel from the start time so that the month-end rules don't drift
+		c := p.traveler(p.start, it.index*p.step)
+		it.index++
+		if c.Error != nil {
+			it.isDone = true
+			return false
+		}
+		if (p.step > 0 && c.Gt(p.end)) || (p.step < 0 && c.Lt(p.end)) {
+			it.isDone = true
+			return false
+		}
+		if it.index == 1 && p.excludeStart {
+			continue
+		}
+		if p.excludeEnd && c.Eq(p.end) {
+			it.isDone = true
+			return false
+		}
+		if !p.isKept(c) {
+			continue
+		}
+		it.current = c
+		return true
+	}
+}
+
+// Current gets the current time.
+// 获取当前时间
+func (it *PeriodIterator) Current() Carbon {
+	return it.current
+}
+
+// reports whether the time is kept by all filters.
+// 是否通过所有过滤器
+func (p Period) isKept(c Carbon) bool {
+	for _, filter := range p.filters {
+		if !filter(c) {
+			return false
+		}
+	}
+	return true
+}
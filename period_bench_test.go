@@ -0,0 +1,27 @@
+package carbon
+
+import "testing"
+
+func BenchmarkPeriod_ToSlice(b *testing.B) {
+	p := NewPeriod(Parse("2020-01-31"), Parse("2020-12-31")).EveryMonthsNoOverflow(1)
+	for n := 0; n < b.N; n++ {
+		p.ToSlice()
+	}
+}
+
+func BenchmarkPeriod_Count(b *testing.B) {
+	p := NewPeriod(Parse("2020-01-01"), Parse("2020-12-31")).Weekdays()
+	for n := 0; n < b.N; n++ {
+		p.Count()
+	}
+}
+
+func BenchmarkPeriod_Iterator(b *testing.B) {
+	p := NewPeriod(Parse("2020-08-01"), Parse("2020-08-31"))
+	for n := 0; n < b.N; n++ {
+		it := p.Iterator()
+		for it.Next() {
+			it.Current()
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriod_ToSlice(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		start, end string
+		period     func(p Period) Period
+		expected   []string
+	}{
+		0:  {"2020-08-05", "2020-08-08", func(p Period) Period { return p }, []string{"2020-08-05", "2020-08-06", "2020-08-07", "2020-08-08"}},
+		1:  {"2020-08-05", "2020-08-08", func(p Period) Period { return p.ExcludeStart() }, []string{"2020-08-06", "2020-08-07", "2020-08-08"}},
+		2:  {"2020-08-05", "2020-08-08", func(p Period) Period { return p.ExcludeEnd() }, []string{"2020-08-05", "2020-08-06", "2020-08-07"}},
+		3:  {"2020-08-05", "2020-08-08", func(p Period) Period { return p.ExcludeStart().ExcludeEnd() }, []string{"2020-08-06", "2020-08-07"}},
+		4:  {"2020-08-05", "2020-08-08", func(p Period) Period { return p.ExcludeStart().IncludeStart() }, []string{"2020-08-05", "2020-08-06", "2020-08-07", "2020-08-08"}},
+		5:  {"2020-08-05", "2020-08-08", func(p Period) Period { return p.ExcludeEnd().IncludeEnd() }, []string{"2020-08-05", "2020-08-06", "2020-08-07", "2020-08-08"}},
+		6:  {"2020-08-05", "2020-08-12", func(p Period) Period { return p.EveryDays(3) }, []string{"2020-08-05", "2020-08-08", "2020-08-11"}},
+		7:  {"2020-08-05", "2020-08-19", func(p Period) Period { return p.EveryWeeks(1) }, []string{"2020-08-05", "2020-08-12", "2020-08-19"}},
+		8:  {"2020-01-31", "2020-05-31", func(p Period) Period { return p.EveryMonths(1) }, []string{"2020-01-31", "2020-03-02", "2020-03-31", "2020-05-01", "2020-05-31"}},
+		9:  {"2020-01-31", "2020-05-31", func(p Period) Period { return p.EveryMonthsNoOverflow(1) }, []string{"2020-01-31", "2020-02-29", "2020-03-31", "2020-04-30", "2020-05-31"}},
+		10: {"2020-01-31", "2021-01-31", func(p Period) Period { return p.EveryQuartersNoOverflow(1) }, []string{"2020-01-31", "2020-04-30", "2020-07-31", "2020-10-31", "2021-01-31"}},
+		11: {"2020-02-29", "2022-03-01", func(p Period) Period { return p.EveryYears(1) }, []string{"2020-02-29", "2021-03-01", "2022-03-01"}},
+		12: {"2020-02-29", "2022-03-01", func(p Period) Period { return p.EveryYearsNoOverflow(1) }, []string{"2020-02-29", "2021-02-28", "2022-02-28"}},
+		13: {"2020-08-05", "2020-08-12", func(p Period) Period { return p.Weekdays() }, []string{"2020-08-05", "2020-08-06", "2020-08-07", "2020-08-10", "2020-08-11", "2020-08-12"}},
+		14: {"2020-08-05", "2020-08-12", func(p Period) Period { return p.Weekends() }, []string{"2020-08-08", "2020-08-09"}},
+		15: {"2020-08-08", "2020-08-05", func(p Period) Period { return p.EveryDays(-1) }, []string{"2020-08-08", "2020-08-07", "2020-08-06", "2020-08-05"}},
+		16: {"2020-08-08", "2020-08-05", func(p Period) Period { return p }, []string{}},
+		17: {"2020-08-05", "2020-08-08", func(p Period) Period { return p.Filter(func(c Carbon) bool { return c.Day()%2 == 0 }) }, []string{"2020-08-06", "2020-08-08"}},
+	}
+
+	for index, test := range tests {
+		p := test.period(NewPeriod(Parse(test.start), Parse(test.end)))
+		assert.Nil(p.Error)
+		actual := make([]string, 0)
+		for _, c := range p.ToSlice() {
+			actual = append(actual, c.ToDateString())
+		}
+		assert.Equal(test.expected, actual, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(len(test.expected), p.Count(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestPeriod_EveryTime(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		period   Period
+		expected []string
+	}{
+		0: {Parse("2020-08-05 13:00:00").Period(Parse("2020-08-05 16:00:00")).EveryHours(1), []string{"2020-08-05 13:00:00", "2020-08-05 14:00:00", "2020-08-05 15:00:00", "2020-08-05 16:00:00"}},
+		1: {Parse("2020-08-05 13:00:00").Period(Parse("2020-08-05 13:40:00")).EveryMinutes(15), []string{"2020-08-05 13:00:00", "2020-08-05 13:15:00", "2020-08-05 13:30:00"}},
+		2: {Parse("2020-08-05 13:00:00").Period(Parse("2020-08-05 13:00:02")).EverySeconds(1), []string{"2020-08-05 13:00:00", "2020-08-05 13:00:01", "2020-08-05 13:00:02"}},
+		3: {Parse("2020-08-05 13:00:00").Period(Parse("2020-08-05 14:00:00")).EveryDuration(25 * time.Minute), []string{"2020-08-05 13:00:00", "2020-08-05 13:25:00", "2020-08-05 13:50:00"}},
+		4: {Parse("2020-08-05 14:00:00").Period(Parse("2020-08-05 13:00:00")).EveryDuration(-30 * time.Minute), []string{"2020-08-05 14:00:00", "2020-08-05 13:30:00", "2020-08-05 13:00:00"}},
+	}
+
+	for index, test := range tests {
+		assert.Nil(test.period.Error)
+		actual := make([]string, 0)
+		for _, c := range test.period.ToSlice() {
+			actual = append(actual, c.ToDateTimeString())
+		}
+		assert.Equal(test.expected, actual, "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestPeriod_Iterator(t *testing.T) {
+	assert := assert.New(t)
+
+	p := NewPeriod(Parse("2020-08-05", PRC), Parse("2020-08-07", PRC))
+	assert.Equal("2020-08-05", p.Start().ToDateString())
+	assert.Equal("2020-08-07", p.End().ToDateString())
+
+	it := p.Iterator()
+	actual := make([]string, 0)
+	for it.Next() {
+		assert.Equal(PRC, it.Current().Location())
+		actual = append(actual, it.Current().ToDateString())
+	}
+	assert.Equal([]string{"2020-08-05", "2020-08-06", "2020-08-07"}, actual)
+	assert.False(it.Next())
+
+	count := 0
+	p.ForEach(func(c Carbon) bool {
+		count++
+		return count < 2
+	})
+	assert.Equal(2, count)
+}
+
+func TestPeriod_Inherit(t *testing.T) {
+	assert := assert.New(t)
+
+	start := Parse("2020-08-05", PRC).SetLocale("zh-CN").SetWeekStartsAt(Monday)
+	slice := start.Period(start.AddDays(2)).ToSlice()
+	assert.Len(slice, 3)
+	for _, c := range slice {
+		assert.Equal(PRC, c.Location())
+		assert.Equal(time.Monday, c.weekStartsAt)
+		assert.Equal("zh-CN", c.Locale())
+	}
+	assert.Equal("2020-08-03", slice[2].StartOfWeek().ToDateString())
+}
+
+func TestError_Period(t *testing.T) {
+	p1 := NewPeriod(Parse("xxx"), Parse("2020-08-05"))
+	assert.NotNil(t, p1.Error, "It should catch an exception in NewPeriod()")
+	assert.Equal(t, 0, p1.Count())
+
+	p2 := NewPeriod(Parse("2020-08-05"), Parse("xxx"))
+	assert.NotNil(t, p2.Error, "It should catch an exception in NewPeriod()")
+	assert.Empty(t, p2.ToSlice())
+
+	p3 := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).EveryDays(0)
+	assert.NotNil(t, p3.Error, "It should catch an exception in EveryDays()")
+
+	p4 := NewPeriod(Parse("2020-08-05"), Parse("2020-08-06")).EveryDuration(0)
+	assert.NotNil(t, p4.Error, "It should catch an exception in EveryDuration()")
+}
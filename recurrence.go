@@ -0,0 +1,1128 @@
+package carbon
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurrence frequencies, the order matters
+// 重复频率，顺序不能改变
+const (
+	yearlyFrequency = iota
+	monthlyFrequency
+	weeklyFrequency
+	dailyFrequency
+	hourlyFrequency
+	minutelyFrequency
+	secondlyFrequency
+)
+
+// recurrence limits
+// 重复规则限制
+const (
+	maxRecurrenceYear         = 9999   // 最大年份
+	maxRecurrenceEmptyPeriods = 100000 // 最大连续无结果周期数
+)
+
+var (
+	recurrenceFrequencies = []string{"YEARLY", "MONTHLY", "WEEKLY", "DAILY", "HOURLY", "MINUTELY", "SECONDLY"}
+	recurrenceWeekdays    = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+)
+
+// Recurrence defines a Recurrence struct, it is a recurrence set defined by RFC 5545,
+// see https://datatracker.ietf.org/doc/html/rfc5545#section-3.8.5.
+// 定义 Recurrence 结构体，即 RFC 5545 定义的重复集合
+type Recurrence struct {
+	dtstart Carbon
+	isDate  bool
+	rrules  []rrule
+	exrules []rrule
+	rdates  []Carbon
+	exdates []Carbon
+	Error   error
+}
+
+// recurrence rule
+// 重复规则
+type rrule struct {
+	freq       int
+	interval   int
+	count      int
+	until      string
+	wkst       time.Weekday
+	bySecond   []int
+	byMinute   []int
+	byHour     []int
+	byDay      []recurrenceWeekday
+	byMonthDay []int
+	byYearDay  []int
+	byWeekNo   []int
+	byMonth    []int
+	bySetPos   []int
+}
+
+// weekday of the recurrence rule like "-1FR"
+// 重复规则的星期，如 "-1FR"
+type recurrenceWeekday struct {
+	nth     int
+	weekday time.Weekday
+}
+
+// NewRecurrence returns a new Recurrence instance starting at dtstart, the occurrences are in the timezone of dtstart.
+// 初始化 Recurrence 结构体，所有重复时间的时区与开始时间一致
+func NewRecurrence(dtstart Carbon) Recurrence {
+	r := Recurrence{dtstart: dtstart}
+	if dtstart.IsInvalid() {
+		r.Error = dtstart.Error
+		if r.Error == nil {
+			r.Error = invalidRecurrenceError("")
+		}
+		return r
+	}
+	// the precision of RFC 5545 is second
+	r.dtstart.time = dtstart.time.Truncate(time.Second)
+	return r
+}
+
+// ParseRecurrence parses lines of DTSTART, RRULE, EXRULE, RDATE and EXDATE as a Recurrence instance.
+// The timezone is used for the floating date-times which have neither TZID nor UTC designator.
+// 解析 DTSTART、RRULE、EXRULE、RDATE 和 EXDATE 为 Recurrence 实例，时区用于既没有 TZID 也没有 UTC 标识的时间
+func ParseRecurrence(value string, timezone ...string) Recurrence {
+	r := Recurrence{dtstart: NewCarbon()}
+	if len(timezone) > 0 {
+		r.dtstart.loc, r.Error = getLocationByTimezone(timezone[0])
+	}
+	if r.Error != nil {
+		return r
+	}
+	lines := parseRecurrenceLines(value)
+	hasStart := false
+	for _, line := range lines {
+		if line.name != "DTSTART" {
+			continue
+		}
+		t, loc, isDate, err := parseRecurrenceDateTime(line.value, line.params, r.dtstart.loc)
+		if err != nil {
+			r.Error = err
+			return r
+		}
+		r.dtstart.time, r.dtstart.loc, r.isDate, hasStart = t, loc, isDate, true
+	}
+	if !hasStart {
+		r.Error = invalidRecurrenceError(value)
+		return r
+	}
+	for _, line := range lines {
+		switch line.name {
+		case "DTSTART":
+		case "RRULE":
+			r = r.AddRRule(line.value)
+		case "EXRULE":
+			r = r.AddExRule(line.value)
+		case "RDATE", "EXDATE":
+			for _, v := range strings.Split(line.value, ",") {
+				t, _, _, err := parseRecurrenceDateTime(v, line.params, r.dtstart.loc)
+				if err != nil {
+					r.Error = err
+					return r
+				}
+				c := r.dtstart
+				c.time = t
+				if line.name == "RDATE" {
+					r = r.AddRDates(c)
+				} else {
+					r = r.AddExDates(c)
+				}
+			}
+		default:
+			r.Error = invalidRecurrenceError(line.name)
+		}
+		if r.Error != nil {
+			return r
+		}
+	}
+	return r
+}
+
+// Recurrence returns a new Recurrence instance starting at the current Carbon instance with some RRULE strings.
+// 返回从当前时间开始的 Recurrence 实例
+func (c Carbon) Recurrence(rules ...string) Recurrence {
+	r := NewRecurrence(c)
+	for _, rule := range rules {
+		r = r.AddRRule(rule)
+	}
+	return r
+}
+
+// AddRRule adds a RRULE like "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU", the "RRULE:" prefix is optional.
+// 添加 RRULE 重复规则
+func (r Recurrence) AddRRule(rule string) Recurrence {
+	if r.Error != nil {
+		return r
+	}
+	parsed, err := parseRRule(rule, "RRULE:")
+	if err != nil {
+		r.Error = err
+		return r
+	}
+	rules := make([]rrule, len(r.rrules), len(r.rrules)+1)
+	copy(rules, r.rrules)
+	r.rrules = append(rules, parsed)
+	return r
+}
+
+// AddExRule adds an EXRULE like "FREQ=YEARLY;BYMONTH=12;BYMONTHDAY=25", the "EXRULE:" prefix is optional.
+// 添加 EXRULE 排除规则
+func (r Recurrence) AddExRule(rule string) Recurrence {
+	if r.Error != nil {
+		return r
+	}
+	parsed, err := parseRRule(rule, "EXRULE:")
+	if err != nil {
+		r.Error = err
+		return r
+	}
+	rules := make([]rrule, len(r.exrules), len(r.exrules)+1)
+	copy(rules, r.exrules)
+	r.exrules = append(rules, parsed)
+	return r
+}
+
+// AddRDates adds some RDATE times.
+// 添加 RDATE 时间
+func (r Recurrence) AddRDates(dates ...Carbon) Recurrence {
+	r.rdates, r.Error = r.addDates(r.rdates, dates)
+	return r
+}
+
+// AddExDates adds some EXDATE times.
+// 添加 EXDATE 排除时间
+func (r Recurrence) AddExDates(dates ...Carbon) Recurrence {
+	r.exdates, r.Error = r.addDates(r.exdates, dates)
+	return r
+}
+
+// Start gets the start time.
+// 获取开始时间
+func (r Recurrence) Start() Carbon {
+	return r.dtstart
+}
+
+// ForEach calls the function for each occurrence in order until the function returns false.
+// 按顺序遍历每个重复时间，函数返回 false 时停止
+func (r Recurrence) ForEach(fn func(c Carbon) bool) {
+	r.iterate(func(t time.Time) bool {
+		return fn(r.toCarbon(t))
+	})
+}
+
+// Between gets all occurrences between the start and end time, both times are included.
+// 获取开始时间和结束时间之间的所有重复时间(包含两端)
+func (r Recurrence) Between(start, end Carbon) []Carbon {
+	occurrences := make([]Carbon, 0)
+	if start.IsInvalid() || end.IsInvalid() {
+		return occurrences
+	}
+	from, to := start.ToStdTime(), end.ToStdTime()
+	r.iterate(func(t time.Time) bool {
+		if t.After(to) {
+			return false
+		}
+		if !t.Before(from) {
+			occurrences = append(occurrences, r.toCarbon(t))
+		}
+		return true
+	})
+	return occurrences
+}
+
+// After gets the first occurrence after the given time, it returns an invalid Carbon instance if there isn't any.
+// 获取给定时间之后的第一个重复时间，不存在时返回无效的 Carbon 实例
+func (r Recurrence) After(c Carbon) Carbon {
+	occurrence := r.toCarbon(time.Time{})
+	if c.IsInvalid() {
+		return occurrence
+	}
+	r.iterate(func(t time.Time) bool {
+		if t.After(c.ToStdTime()) {
+			occurrence = r.toCarbon(t)
+			return false
+		}
+		return true
+	})
+	return occurrence
+}
+
+// Before gets the last occurrence before the given time, it returns an invalid Carbon instance if there isn't any.
+// 获取给定时间之前的最后一个重复时间，不存在时返回无效的 Carbon 实例
+func (r Recurrence) Before(c Carbon) Carbon {
+	occurrence := r.toCarbon(time.Time{})
+	if c.IsInvalid() {
+		return occurrence
+	}
+	r.iterate(func(t time.Time) bool {
+		if !t.Before(c.ToStdTime()) {
+			return false
+		}
+		occurrence = r.toCarbon(t)
+		return true
+	})
+	return occurrence
+}
+
+// Count gets the number of occurrences, it returns -1 if the recurrence is infinite.
+// 获取重复次数，无限重复时返回 -1
+func (r Recurrence) Count() int {
+	for _, rule := range r.rrules {
+		if rule.count == 0 && rule.until == "" {
+			return -1
+		}
+	}
+	count := 0
+	r.iterate(func(t time.Time) bool {
+		count++
+		return true
+	})
+	return count
+}
+
+// String implements the interface Stringer for Recurrence struct, it outputs the lines of DTSTART, RRULE, EXRULE, RDATE and EXDATE.
+// 实现 Stringer 接口，输出 DTSTART、RRULE、EXRULE、RDATE 和 EXDATE
+func (r Recurrence) String() string {
+	if r.Error != nil || r.dtstart.IsInvalid() {
+		return ""
+	}
+	lines := []string{"DTSTART" + r.formatDates([]Carbon{r.dtstart})}
+	for _, rule := range r.rrules {
+		lines = append(lines, "RRULE:"+rule.String())
+	}
+	for _, rule := range r.exrules {
+		lines = append(lines, "EXRULE:"+rule.String())
+	}
+	if len(r.rdates) > 0 {
+		lines = append(lines, "RDATE"+r.formatDates(r.rdates))
+	}
+	if len(r.exdates) > 0 {
+		lines = append(lines, "EXDATE"+r.formatDates(r.exdates))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// String outputs the rule like "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU".
+// 输出重复规则
+func (r rrule) String() string {
+	parts := []string{"FREQ=" + recurrenceFrequencies[r.freq]}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if r.until != "" {
+		parts = append(parts, "UNTIL="+r.until)
+	}
+	join := func(name string, values []int) {
+		if len(values) == 0 {
+			return
+		}
+		strs := make([]string, len(values))
+		for i, value := range values {
+			strs[i] = strconv.Itoa(value)
+		}
+		parts = append(parts, name+"="+strings.Join(strs, ","))
+	}
+	join("BYMONTH", r.byMonth)
+	join("BYWEEKNO", r.byWeekNo)
+	join("BYYEARDAY", r.byYearDay)
+	join("BYMONTHDAY", r.byMonthDay)
+	if len(r.byDay) > 0 {
+		days := make([]string, len(r.byDay))
+		for i, day := range r.byDay {
+			days[i] = recurrenceWeekdays[day.weekday]
+			if day.nth != 0 {
+				days[i] = strconv.Itoa(day.nth) + days[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	join("BYHOUR", r.byHour)
+	join("BYMINUTE", r.byMinute)
+	join("BYSECOND", r.bySecond)
+	join("BYSETPOS", r.bySetPos)
+	if r.wkst != time.Monday {
+		parts = append(parts, "WKST="+recurrenceWeekdays[r.wkst])
+	}
+	return strings.Join(parts, ";")
+}
+
+// iterates all occurrences in order, the DTSTART is always the first occurrence.
+// 按顺序迭代所有重复时间，DTSTART 总是第一个重复时间
+func (r Recurrence) iterate(fn func(t time.Time) bool) {
+	if r.Error != nil || r.dtstart.IsInvalid() {
+		return
+	}
+	start := r.dtstart.ToStdTime()
+	includes := make([]*rruleIterator, len(r.rrules))
+	for i, rule := range r.rrules {
+		includes[i] = newRRuleIterator(rule, start, r.dtstart.loc, true)
+	}
+	excludes := make([]*rruleIterator, len(r.exrules))
+	for i, rule := range r.exrules {
+		excludes[i] = newRRuleIterator(rule, start, r.dtstart.loc, false)
+	}
+	rdates := []time.Time{start}
+	for _, date := range r.rdates {
+		rdates = append(rdates, date.ToStdTime())
+	}
+	sort.Slice(rdates, func(i, j int) bool {
+		return rdates[i].Before(rdates[j])
+	})
+	exdates := make(map[int64]bool, len(r.exdates))
+	for _, date := range r.exdates {
+		exdates[date.ToStdTime().UnixNano()] = true
+	}
+
+	for {
+		var next time.Time
+		found := false
+		for _, it := range includes {
+			if t, ok := it.peek(); ok && (!found || t.Before(next)) {
+				next, found = t, true
+			}
+		}
+		if len(rdates) > 0 && (!found || rdates[0].Before(next)) {
+			next, found = rdates[0], true
+		}
+		if !found {
+			return
+		}
+		// pops all the same occurrences to remove duplicates
+		for _, it := range includes {
+			if t, ok := it.peek(); ok && t.Equal(next) {
+				it.pop()
+			}
+		}
+		for len(rdates) > 0 && rdates[0].Equal(next) {
+			rdates = rdates[1:]
+		}
+		if exdates[next.UnixNano()] || isExcludedByRRules(next, excludes) {
+			continue
+		}
+		if !fn(next) {
+			return
+		}
+	}
+}
+
+// adds some dates to the slice without modifying the original slice.
+// 添加时间到切片，不修改原切片
+func (r Recurrence) addDates(slice []Carbon, dates []Carbon) ([]Carbon, error) {
+	if r.Error != nil {
+		return slice, r.Error
+	}
+	result := make([]Carbon, len(slice), len(slice)+len(dates))
+	copy(result, slice)
+	for _, date := range dates {
+		if date.IsInvalid() {
+			if date.Error != nil {
+				return slice, date.Error
+			}
+			return slice, invalidRecurrenceError("")
+		}
+		c := r.dtstart
+		c.time = date.time.Truncate(time.Second)
+		result = append(result, c)
+	}
+	return result, nil
+}
+
+// converts the time to a Carbon instance with the same settings as DTSTART.
+// 转换为与 DTSTART 设置相同的 Carbon 实例
+func (r Recurrence) toCarbon(t time.Time) Carbon {
+	c := r.dtstart
+	c.time = t
+	return c
+}
+
+// formats the dates as the parameters and values like ";TZID=Asia/Shanghai:20200805T131415".
+// 格式化时间为参数和值，如 ";TZID=Asia/Shanghai:20200805T131415"
+func (r Recurrence) formatDates(dates []Carbon) string {
+	loc := r.dtstart.loc
+	values := make([]string, len(dates))
+	for i, date := range dates {
+		t := date.time.In(loc)
+		switch {
+		case r.isDate:
+			values[i] = t.Format(RFC5545DateLayout)
+		case loc.String() == UTC:
+			values[i] = t.Format(RFC5545DateTimeUTCLayout)
+		default:
+			values[i] = t.Format(RFC5545DateTimeLayout)
+		}
+	}
+	switch {
+	case r.isDate:
+		return ";VALUE=DATE:" + strings.Join(values, ",")
+	case loc.String() == UTC, loc == time.Local:
+		return ":" + strings.Join(values, ",")
+	}
+	return ";TZID=" + loc.String() + ":" + strings.Join(values, ",")
+}
+
+// reports whether the time is excluded by the EXRULE iterators.
+// 是否被 EXRULE 排除
+func isExcludedByRRules(t time.Time, excludes []*rruleIterator) bool {
+	for _, it := range excludes {
+		for {
+			next, ok := it.peek()
+			if !ok || !next.Before(t) {
+				if ok && next.Equal(t) {
+					return true
+				}
+				break
+			}
+			it.pop()
+		}
+	}
+	return false
+}
+
+// line of the recurrence like "DTSTART;TZID=Asia/Shanghai:20200805T131415"
+// 重复规则的行
+type recurrenceLine struct {
+	raw    string
+	name   string
+	params map[string]string
+	value  string
+}
+
+// parses the lines of the recurrence, the folded lines are unfolded. Only the line endings are stripped
+// since the trailing whitespace of a value like SUMMARY is significant.
+// 解析重复规则的行，折叠行会被展开，只去除行尾换行符，因为 SUMMARY 等值的尾部空白是有意义的
+func parseRecurrenceLines(value string) []recurrenceLine {
+	value = strings.NewReplacer("\r\n ", "", "\r\n\t", "", "\n ", "", "\n\t", "").Replace(value)
+	lines := make([]recurrenceLine, 0)
+	for _, text := range strings.Split(value, "\n") {
+		text = strings.TrimLeft(strings.TrimSuffix(text, "\r"), " \t")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		line := recurrenceLine{raw: text, params: make(map[string]string)}
+		if i := indexUnquoted(text, ':'); i >= 0 {
+			text, line.value = text[:i], text[i+1:]
+		}
+		parts := splitUnquoted(text, ';')
+		line.name = strings.ToUpper(strings.TrimSpace(parts[0]))
+		for _, param := range parts[1:] {
+			if kv := strings.SplitN(param, "=", 2); len(kv) == 2 {
+				line.params[strings.ToUpper(kv[0])] = strings.Trim(kv[1], `"`)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// gets the index of the first separator which is not in a quoted parameter value like ALTREP="http://example.com".
+// 获取第一个不在引号参数值中的分隔符的索引
+func indexUnquoted(text string, separator byte) int {
+	quoted := false
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			quoted = !quoted
+		case separator:
+			if !quoted {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splits the text by the separators which are not in quoted parameter values.
+// 按不在引号参数值中的分隔符拆分文本
+func splitUnquoted(text string, separator byte) []string {
+	parts := make([]string, 0)
+	for i := indexUnquoted(text, separator); i >= 0; i = indexUnquoted(text, separator) {
+		parts = append(parts, text[:i])
+		text = text[i+1:]
+	}
+	return append(parts, text)
+}
+
+// parses a DATE or DATE-TIME value with TZID and VALUE parameters.
+// 解析带 TZID 和 VALUE 参数的 DATE 或 DATE-TIME 值
+func parseRecurrenceDateTime(value string, params map[string]string, loc *time.Location) (t time.Time, _ *time.Location, isDate bool, err error) {
+	value = strings.TrimSpace(value)
+	if tzid, ok := params["TZID"]; ok {
+		if loc, err = getLocationByTimezone(tzid); err != nil {
+			return
+		}
+	}
+	var naive time.Time
+	switch {
+	case strings.ToUpper(params["VALUE"]) == "PERIOD":
+		err = invalidRecurrenceError(value)
+		return
+	case strings.HasSuffix(value, "Z"):
+		t, err = time.Parse(RFC5545DateTimeUTCLayout, value)
+		if err != nil {
+			err = invalidRecurrenceError(value)
+		}
+		return t, time.UTC, false, err
+	case len(value) == len(RFC5545DateLayout):
+		naive, err = time.Parse(RFC5545DateLayout, value)
+		isDate = true
+	default:
+		naive, err = time.Parse(RFC5545DateTimeLayout, value)
+	}
+	if err != nil {
+		err = invalidRecurrenceError(value)
+		return
+	}
+	return getWallClockTime(naive, loc), loc, isDate, nil
+}
+
+// parses a RRULE or EXRULE.
+// 解析 RRULE 或 EXRULE
+func parseRRule(value, prefix string) (r rrule, err error) {
+	r = rrule{freq: -1, interval: 1, wkst: time.Monday}
+	rule := strings.ToUpper(strings.TrimSpace(value))
+	rule = strings.TrimPrefix(rule, prefix)
+	if rule == "" {
+		return r, invalidRecurrenceError(value)
+	}
+	ok := true
+	for _, part := range strings.Split(rule, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return r, invalidRecurrenceError(value)
+		}
+		key, val := kv[0], kv[1]
+		switch key {
+		case "FREQ":
+			r.freq = -1
+			for i, freq := range recurrenceFrequencies {
+				if freq == val {
+					r.freq = i
+				}
+			}
+			ok = r.freq >= 0
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(val)
+			ok = err == nil && r.interval > 0
+		case "COUNT":
+			r.count, err = strconv.Atoi(val)
+			ok = err == nil && r.count > 0
+		case "UNTIL":
+			r.until = val
+			_, err = parseRecurrenceUntil(val, time.UTC)
+			ok = err == nil
+		case "WKST":
+			var days []recurrenceWeekday
+			days, ok = parseRecurrenceWeekdays(val)
+			if ok = ok && len(days) == 1 && days[0].nth == 0; ok {
+				r.wkst = days[0].weekday
+			}
+		case "BYSECOND":
+			r.bySecond, ok = parseRecurrenceInts(val, 0, 60, false)
+		case "BYMINUTE":
+			r.byMinute, ok = parseRecurrenceInts(val, 0, 59, false)
+		case "BYHOUR":
+			r.byHour, ok = parseRecurrenceInts(val, 0, 23, false)
+		case "BYDAY":
+			r.byDay, ok = parseRecurrenceWeekdays(val)
+		case "BYMONTHDAY":
+			r.byMonthDay, ok = parseRecurrenceInts(val, 1, 31, true)
+		case "BYYEARDAY":
+			r.byYearDay, ok = parseRecurrenceInts(val, 1, 366, true)
+		case "BYWEEKNO":
+			r.byWeekNo, ok = parseRecurrenceInts(val, 1, 53, true)
+		case "BYMONTH":
+			r.byMonth, ok = parseRecurrenceInts(val, 1, 12, false)
+		case "BYSETPOS":
+			r.bySetPos, ok = parseRecurrenceInts(val, 1, 366, true)
+		default:
+			ok = false
+		}
+		if !ok {
+			return r, invalidRecurrenceError(value)
+		}
+	}
+	if !r.isValid() {
+		return r, invalidRecurrenceError(value)
+	}
+	return r, nil
+}
+
+// reports whether the rule parts are valid together, see https://datatracker.ietf.org/doc/html/rfc5545#section-3.3.10.
+// 规则各部分组合是否有效
+func (r rrule) isValid() bool {
+	if r.freq < 0 || (r.count > 0 && r.until != "") {
+		return false
+	}
+	if len(r.byWeekNo) > 0 && r.freq != yearlyFrequency {
+		return false
+	}
+	if len(r.byYearDay) > 0 && (r.freq == dailyFrequency || r.freq == weeklyFrequency || r.freq == monthlyFrequency) {
+		return false
+	}
+	if len(r.byMonthDay) > 0 && r.freq == weeklyFrequency {
+		return false
+	}
+	for _, day := range r.byDay {
+		if day.nth != 0 && (r.freq >= weeklyFrequency || len(r.byWeekNo) > 0) {
+			return false
+		}
+	}
+	if len(r.bySetPos) > 0 && len(r.bySecond)+len(r.byMinute)+len(r.byHour)+len(r.byDay)+len(r.byMonthDay)+len(r.byYearDay)+len(r.byWeekNo)+len(r.byMonth) == 0 {
+		return false
+	}
+	return true
+}
+
+// fills the default rule parts by DTSTART.
+// 根据 DTSTART 填充默认规则部分
+func (r rrule) withDefaults(start time.Time) rrule {
+	if len(r.byWeekNo)+len(r.byYearDay)+len(r.byMonthDay)+len(r.byDay) == 0 {
+		switch r.freq {
+		case yearlyFrequency:
+			if len(r.byMonth) == 0 {
+				r.byMonth = []int{int(start.Month())}
+			}
+			r.byMonthDay = []int{start.Day()}
+		case monthlyFrequency:
+			r.byMonthDay = []int{start.Day()}
+		case weeklyFrequency:
+			r.byDay = []recurrenceWeekday{{weekday: start.Weekday()}}
+		}
+	}
+	sorted := func(values []int, value int, isDefault bool) []int {
+		if len(values) == 0 && isDefault {
+			return []int{value}
+		}
+		result := make([]int, len(values))
+		copy(result, values)
+		sort.Ints(result)
+		return result
+	}
+	r.byHour = sorted(r.byHour, start.Hour(), r.freq < hourlyFrequency)
+	r.byMinute = sorted(r.byMinute, start.Minute(), r.freq < minutelyFrequency)
+	r.bySecond = sorted(r.bySecond, start.Second(), r.freq < secondlyFrequency)
+	return r
+}
+
+// rruleIterator defines a rruleIterator struct, it expands a rule in wall clock times which have no timezone.
+// 定义 rruleIterator 结构体，以无时区的挂钟时间展开重复规则
+type rruleIterator struct {
+	rule    rrule
+	loc     *time.Location
+	start   time.Time // DTSTART in wall clock
+	startAt time.Time
+	until   time.Time
+	index   int
+	count   int
+	empty   int
+	buffer  []time.Time
+	last    time.Time
+	isDone  bool
+}
+
+// returns a new rruleIterator instance, the DTSTART is counted as the first occurrence if isStart is true.
+// 初始化 rruleIterator 结构体，isStart 为 true 时 DTSTART 计为第一个重复时间
+func newRRuleIterator(rule rrule, start time.Time, loc *time.Location, isStart bool) *rruleIterator {
+	wall := time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), start.Minute(), start.Second(), 0, time.UTC)
+	it := &rruleIterator{rule: rule.withDefaults(wall), loc: loc, start: wall, startAt: start}
+	if rule.until != "" {
+		it.until, _ = parseRecurrenceUntil(rule.until, loc)
+	}
+	if isStart && (it.until.IsZero() || !start.After(it.until)) {
+		it.buffer, it.last, it.count = []time.Time{start}, start, 1
+		it.isDone = rule.count == 1
+	}
+	return it
+}
+
+// peeks the next occurrence.
+// 查看下一个重复时间
+func (it *rruleIterator) peek() (time.Time, bool) {
+	for len(it.buffer) == 0 && !it.isDone {
+		it.fill()
+	}
+	if len(it.buffer) == 0 {
+		return time.Time{}, false
+	}
+	return it.buffer[0], true
+}
+
+// pops the next occurrence.
+// 取出下一个重复时间
+func (it *rruleIterator) pop() {
+	if len(it.buffer) > 0 {
+		it.buffer = it.buffer[1:]
+	}
+}
+
+// fills the buffer with the occurrences of the next period.
+// 用下一个周期的重复时间填充缓冲区
+func (it *rruleIterator) fill() {
+	r := it.rule
+	base := it.period(it.index)
+	if base.Year() > maxRecurrenceYear || it.empty > maxRecurrenceEmptyPeriods {
+		it.isDone = true
+		return
+	}
+	if r.freq >= hourlyFrequency && it.skip(base) {
+		it.empty++
+		return
+	}
+	it.index++
+	found := false
+	for _, wall := range r.expand(base) {
+		t := getWallClockTime(wall, it.loc)
+		if t.Before(it.startAt) || !t.After(it.last) {
+			continue
+		}
+		if !it.until.IsZero() && t.After(it.until) {
+			it.isDone = true
+			break
+		}
+		it.buffer, it.last, found = append(it.buffer, t), t, true
+		if it.count++; r.count > 0 && it.count >= r.count {
+			it.isDone = true
+			break
+		}
+	}
+	if found {
+		it.empty = 0
+		return
+	}
+	it.empty++
+}
+
+// gets the start of the period by index.
+// 根据索引获取周期的开始时间
+func (it *rruleIterator) period(index int) time.Time {
+	r, s := it.rule, it.start
+	day := time.Date(s.Year(), s.Month(), s.Day(), 0, 0, 0, 0, time.UTC)
+	switch r.freq {
+	case yearlyFrequency:
+		return time.Date(s.Year()+index*r.interval, 1, 1, 0, 0, 0, 0, time.UTC)
+	case monthlyFrequency:
+		months := int(s.Month()) - 1 + index*r.interval
+		return time.Date(s.Year()+months/MonthsPerYear, time.Month(months%MonthsPerYear+1), 1, 0, 0, 0, 0, time.UTC)
+	case weeklyFrequency:
+		offset := (int(s.Weekday()) - int(r.wkst) + DaysPerWeek) % DaysPerWeek
+		return day.AddDate(0, 0, index*r.interval*DaysPerWeek-offset)
+	case dailyFrequency:
+		return day.AddDate(0, 0, index*r.interval)
+	}
+	unit := r.unit()
+	return time.Unix(s.Truncate(time.Duration(unit)*time.Second).Unix()+int64(index)*int64(r.interval)*unit, 0).UTC()
+}
+
+// skips the periods which can't match the BYxxx rule parts if the frequency is less than a day.
+// 频率小于一天时，跳过不可能匹配的周期
+func (it *rruleIterator) skip(base time.Time) bool {
+	r := it.rule
+	next := time.Time{}
+	switch {
+	case !r.matchesDay(time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC), base.Year()):
+		next = time.Date(base.Year(), base.Month(), base.Day()+1, 0, 0, 0, 0, time.UTC)
+	case r.freq > hourlyFrequency && !matchesRecurrenceInts(r.byHour, base.Hour()):
+		next = base.Truncate(time.Hour).Add(time.Hour)
+	case r.freq > minutelyFrequency && !matchesRecurrenceInts(r.byMinute, base.Minute()):
+		next = base.Truncate(time.Minute).Add(time.Minute)
+	default:
+		return false
+	}
+	step := int64(r.interval) * r.unit()
+	index := int((next.Unix() - it.period(0).Unix() + step - 1) / step)
+	if index <= it.index {
+		index = it.index + 1
+	}
+	it.index = index
+	return true
+}
+
+// gets the seconds of the frequency unit which is less than a day.
+// 获取小于一天的频率单位的秒数
+func (r rrule) unit() int64 {
+	switch r.freq {
+	case hourlyFrequency:
+		return SecondsPerHour
+	case minutelyFrequency:
+		return SecondsPerMinute
+	}
+	return 1
+}
+
+// expands the period to the wall clock times in order, BYSETPOS is applied.
+// 按顺序展开周期内的挂钟时间，并应用 BYSETPOS
+func (r rrule) expand(base time.Time) []time.Time {
+	var from, to time.Time
+	switch r.freq {
+	case yearlyFrequency:
+		from, to = base, base.AddDate(1, 0, 0)
+		if len(r.byWeekNo) > 0 {
+			// the weeks of the year may start in the previous year and end in the next year
+			if start := getRecurrenceWeekOneStart(base.Year(), r.wkst); start.Before(from) {
+				from = start
+			}
+			if end := getRecurrenceWeekOneStart(base.Year()+1, r.wkst); end.After(to) {
+				to = end
+			}
+		}
+	case monthlyFrequency:
+		from, to = base, base.AddDate(0, 1, 0)
+	case weeklyFrequency:
+		from, to = base, base.AddDate(0, 0, DaysPerWeek)
+	default:
+		from = time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, time.UTC)
+		to = from.AddDate(0, 0, 1)
+	}
+
+	// the BYxxx rule parts which are less than the frequency expand the period, otherwise they limit the period
+	limit := func(values []int, value int) []int {
+		if matchesRecurrenceInts(values, value) {
+			return []int{value}
+		}
+		return nil
+	}
+	hours, minutes, seconds := r.byHour, r.byMinute, r.bySecond
+	if r.freq >= hourlyFrequency {
+		hours = limit(r.byHour, base.Hour())
+	}
+	if r.freq >= minutelyFrequency {
+		minutes = limit(r.byMinute, base.Minute())
+	}
+	if r.freq >= secondlyFrequency {
+		seconds = limit(r.bySecond, base.Second())
+	}
+
+	times := make([]time.Time, 0)
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !r.matchesDay(day, base.Year()) {
+			continue
+		}
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					// the leap second can't be represented
+					if second == 60 {
+						continue
+					}
+					times = append(times, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, time.UTC))
+				}
+			}
+		}
+	}
+	if len(r.bySetPos) == 0 {
+		return times
+	}
+
+	selected := make([]time.Time, 0, len(r.bySetPos))
+	for _, pos := range r.bySetPos {
+		index := pos - 1
+		if pos < 0 {
+			index = len(times) + pos
+		}
+		if index >= 0 && index < len(times) {
+			selected = append(selected, times[index])
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Before(selected[j])
+	})
+	return selected
+}
+
+// reports whether the day matches the BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY rule parts.
+// 日期是否匹配 BYMONTH、BYWEEKNO、BYYEARDAY、BYMONTHDAY 和 BYDAY
+func (r rrule) matchesDay(day time.Time, year int) bool {
+	if len(r.byMonth) > 0 && !matchesRecurrenceInts(r.byMonth, int(day.Month())) {
+		return false
+	}
+	if len(r.byWeekNo) > 0 {
+		weekYear, week, weeks := getRecurrenceWeek(day, r.wkst)
+		if weekYear != year || !matchesRecurrenceSignedInts(r.byWeekNo, week, weeks) {
+			return false
+		}
+	}
+	daysInYear := time.Date(day.Year(), 12, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	if len(r.byYearDay) > 0 && !matchesRecurrenceSignedInts(r.byYearDay, day.YearDay(), daysInYear) {
+		return false
+	}
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if len(r.byMonthDay) > 0 && !matchesRecurrenceSignedInts(r.byMonthDay, day.Day(), daysInMonth) {
+		return false
+	}
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, wd := range r.byDay {
+		if wd.weekday != day.Weekday() {
+			continue
+		}
+		if wd.nth == 0 {
+			return true
+		}
+		// the nth weekday is in the month if the frequency is monthly or BYMONTH is set, otherwise it's in the year
+		index, total := (day.Day()-1)/DaysPerWeek+1, (daysInMonth-(day.Day()-1)%DaysPerWeek-1)/DaysPerWeek+1
+		if r.freq == yearlyFrequency && len(r.byMonth) == 0 {
+			index, total = (day.YearDay()-1)/DaysPerWeek+1, (daysInYear-(day.YearDay()-1)%DaysPerWeek-1)/DaysPerWeek+1
+		}
+		if matchesRecurrenceSignedInts([]int{wd.nth}, index, total) {
+			return true
+		}
+	}
+	return false
+}
+
+// parses an UNTIL value, a DATE value includes the whole day.
+// 解析 UNTIL 值，DATE 值包含一整天
+func parseRecurrenceUntil(value string, loc *time.Location) (time.Time, error) {
+	t, _, isDate, err := parseRecurrenceDateTime(value, nil, loc)
+	if err != nil {
+		return t, err
+	}
+	if isDate {
+		t = getWallClockTime(time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC), loc).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
+// parses a comma separated integer list like "1,-1".
+// 解析逗号分隔的整数列表
+func parseRecurrenceInts(value string, min, max int, signed bool) ([]int, bool) {
+	parts := strings.Split(value, ",")
+	ints := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, false
+		}
+		abs := n
+		if signed && n < 0 {
+			abs = -n
+		}
+		if abs < min || abs > max || (signed && n == 0) {
+			return nil, false
+		}
+		ints[i] = n
+	}
+	return ints, true
+}
+
+// parses a comma separated weekday list like "MO,-1FR".
+// 解析逗号分隔的星期列表
+func parseRecurrenceWeekdays(value string) ([]recurrenceWeekday, bool) {
+	parts := strings.Split(value, ",")
+	days := make([]recurrenceWeekday, len(parts))
+	for i, part := range parts {
+		if len(part) < 2 {
+			return nil, false
+		}
+		weekday := -1
+		for j, name := range recurrenceWeekdays {
+			if name == part[len(part)-2:] {
+				weekday = j
+			}
+		}
+		if weekday < 0 {
+			return nil, false
+		}
+		days[i].weekday = time.Weekday(weekday)
+		if nth := part[:len(part)-2]; nth != "" {
+			n, err := strconv.Atoi(nth)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, false
+			}
+			days[i].nth = n
+		}
+	}
+	return days, true
+}
+
+// reports whether the value is in the list.
+// 值是否在列表中
+func matchesRecurrenceInts(values []int, value int) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// reports whether the value is in the list, a negative number counts from the end.
+// 值是否在列表中，负数从末尾开始计数
+func matchesRecurrenceSignedInts(values []int, value, total int) bool {
+	for _, v := range values {
+		if v == value || (v < 0 && total+v+1 == value) {
+			return true
+		}
+	}
+	return false
+}
+
+// gets the week-numbering year, the week number and the number of weeks in the week-numbering year,
+// the first week is the first week which contains at least four days of the year.
+// 获取周年份、周数和该周年份的总周数，第一周是包含至少四天的第一周
+func getRecurrenceWeek(day time.Time, wkst time.Weekday) (year, week, weeks int) {
+	year = day.Year()
+	start := getRecurrenceWeekOneStart(year, wkst)
+	if next := getRecurrenceWeekOneStart(year+1, wkst); !day.Before(next) {
+		year, start = year+1, next
+	} else if day.Before(start) {
+		year, start = year-1, getRecurrenceWeekOneStart(year-1, wkst)
+	}
+	end := getRecurrenceWeekOneStart(year+1, wkst)
+	week = int(day.Sub(start).Hours()/HoursPerDay)/DaysPerWeek + 1
+	weeks = int(end.Sub(start).Hours()/HoursPerDay) / DaysPerWeek
+	return
+}
+
+// gets the start day of the first week of the year.
+// 获取某年第一周的开始日期
+func getRecurrenceWeekOneStart(year int, wkst time.Weekday) time.Time {
+	first := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(first.Weekday()) - int(wkst) + DaysPerWeek) % DaysPerWeek
+	start := first.AddDate(0, 0, -offset)
+	if offset > 3 {
+		start = start.AddDate(0, 0, DaysPerWeek)
+	}
+	return start
+}
+
+// converts the wall clock time to the time in the location, see https://datatracker.ietf.org/doc/html/rfc5545#section-3.3.5,
+// a nonexistent time uses the offset before the gap and an ambiguous time refers to the first occurrence.
+// 转换挂钟时间为指定时区的时间，不存在的时间使用跳变前的偏移量，有歧义的时间取第一次出现的时间
+func getWallClockTime(wall time.Time, loc *time.Location) time.Time {
+	unix := wall.Unix()
+	_, before := time.Unix(unix-SecondsPerDay, 0).In(loc).Zone()
+	_, after := time.Unix(unix+SecondsPerDay, 0).In(loc).Zone()
+	t1 := time.Unix(unix-int64(before), 0).In(loc)
+	t2 := time.Unix(unix-int64(after), 0).In(loc)
+	isWall := func(t time.Time) bool {
+		y, mo, d := t.Date()
+		h, mi, s := t.Clock()
+		return time.Date(y, mo, d, h, mi, s, 0, time.UTC).Equal(wall)
+	}
+	switch {
+	case isWall(t1) && isWall(t2):
+		if t2.Before(t1) {
+			return t2
+		}
+		return t1
+	case isWall(t2):
+		return t2
+	}
+	return t1
+}
@@ -0,0 +1,55 @@
+package carbon
+
+import "testing"
+
+func BenchmarkParseRecurrence(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		ParseRecurrence("DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8")
+	}
+}
+
+func BenchmarkCarbon_Recurrence(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.Recurrence("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+	}
+}
+
+func BenchmarkRecurrence_Between(b *testing.B) {
+	now := Now()
+	r := now.Recurrence("FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1")
+	for n := 0; n < b.N; n++ {
+		r.Between(now, now.AddYear())
+	}
+}
+
+func BenchmarkRecurrence_After(b *testing.B) {
+	now := Now()
+	r := now.Recurrence("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29")
+	for n := 0; n < b.N; n++ {
+		r.After(now)
+	}
+}
+
+func BenchmarkRecurrence_Before(b *testing.B) {
+	now := Now()
+	r := now.Recurrence("FREQ=WEEKLY;INTERVAL=2;BYDAY=TU")
+	for n := 0; n < b.N; n++ {
+		r.Before(now.AddYear())
+	}
+}
+
+func BenchmarkRecurrence_Count(b *testing.B) {
+	now := Now()
+	r := now.Recurrence("FREQ=DAILY;COUNT=100")
+	for n := 0; n < b.N; n++ {
+		r.Count()
+	}
+}
+
+func BenchmarkRecurrence_String(b *testing.B) {
+	r := ParseRecurrence("DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8")
+	for n := 0; n < b.N; n++ {
+		_ = r.String()
+	}
+}
@@ -0,0 +1,224 @@
+package carbon
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func toRecurrenceStrings(occurrences []Carbon) []string {
+	strs := make([]string, len(occurrences))
+	for i, occurrence := range occurrences {
+		strs[i] = occurrence.ToRfc3339String()
+	}
+	return strs
+}
+
+func TestParseRecurrence(t *testing.T) {
+	assert := assert.New(t)
+
+	start, end := Parse("1997-01-01", UTC), Parse("2030-01-01", UTC)
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		0: {"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-03T09:00:00-04:00", "1997-09-04T09:00:00-04:00",
+		}},
+		1: {"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8", []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-04T09:00:00-04:00", "1997-09-16T09:00:00-04:00", "1997-09-18T09:00:00-04:00",
+			"1997-09-30T09:00:00-04:00", "1997-10-02T09:00:00-04:00", "1997-10-14T09:00:00-04:00", "1997-10-16T09:00:00-04:00",
+		}},
+		2: {"DTSTART;TZID=America/New_York:19970905T090000\nRRULE:FREQ=MONTHLY;COUNT=6;BYDAY=1FR", []string{
+			"1997-09-05T09:00:00-04:00", "1997-10-03T09:00:00-04:00", "1997-11-07T09:00:00-05:00",
+			"1997-12-05T09:00:00-05:00", "1998-01-02T09:00:00-05:00", "1998-02-06T09:00:00-05:00",
+		}},
+		3: {"DTSTART;TZID=America/New_York:19970930T090000\nRRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=5", []string{
+			"1997-09-30T09:00:00-04:00", "1997-10-31T09:00:00-05:00", "1997-11-28T09:00:00-05:00",
+			"1997-12-31T09:00:00-05:00", "1998-01-30T09:00:00-05:00",
+		}},
+		4: {"DTSTART:20200229T080000Z\nRRULE:FREQ=YEARLY;COUNT=3", []string{
+			"2020-02-29T08:00:00Z", "2024-02-29T08:00:00Z", "2028-02-29T08:00:00Z",
+		}},
+		5: {"DTSTART;TZID=America/New_York:19970519T090000\nRRULE:FREQ=YEARLY;BYDAY=20MO;COUNT=3", []string{
+			"1997-05-19T09:00:00-04:00", "1998-05-18T09:00:00-04:00", "1999-05-17T09:00:00-04:00",
+		}},
+		6: {"DTSTART;TZID=America/New_York:19970512T090000\nRRULE:FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3", []string{
+			"1997-05-12T09:00:00-04:00", "1998-05-11T09:00:00-04:00", "1999-05-17T09:00:00-04:00",
+		}},
+		7: {"DTSTART;TZID=America/New_York:19970902T090000\nEXDATE;TZID=America/New_York:19970902T090000\nRRULE:FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=6", []string{
+			"1998-02-13T09:00:00-05:00", "1998-03-13T09:00:00-05:00", "1998-11-13T09:00:00-05:00",
+			"1999-08-13T09:00:00-04:00", "2000-10-13T09:00:00-04:00",
+		}},
+		8: {"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T210000Z", []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-02T12:00:00-04:00", "1997-09-02T15:00:00-04:00",
+		}},
+		9: {"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=MINUTELY;INTERVAL=15;COUNT=4", []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-02T09:15:00-04:00", "1997-09-02T09:30:00-04:00", "1997-09-02T09:45:00-04:00",
+		}},
+		10: {"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=DAILY;BYHOUR=9,10,11;BYMINUTE=0,20,40;COUNT=4", []string{
+			"1997-09-02T09:00:00-04:00", "1997-09-02T09:20:00-04:00", "1997-09-02T09:40:00-04:00", "1997-09-02T10:00:00-04:00",
+		}},
+		11: {"DTSTART;TZID=America/New_York:20240309T023000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"2024-03-09T02:30:00-05:00", "2024-03-10T03:30:00-04:00", "2024-03-11T02:30:00-04:00",
+		}},
+		12: {"DTSTART;TZID=America/New_York:20241102T013000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"2024-11-02T01:30:00-04:00", "2024-11-03T01:30:00-04:00", "2024-11-04T01:30:00-05:00",
+		}},
+		13: {"DTSTART;VALUE=DATE:20200805\nRRULE:FREQ=WEEKLY;UNTIL=20200819\nRDATE;VALUE=DATE:20200807,20200805\nEXRULE:FREQ=MONTHLY;BYMONTHDAY=12", []string{
+			"2020-08-05T00:00:00Z", "2020-08-07T00:00:00Z", "2020-08-19T00:00:00Z",
+		}},
+		14: {"DTSTART:20200805T131415\nRDATE:20200806T131415", []string{
+			"2020-08-05T13:14:15Z", "2020-08-06T13:14:15Z",
+		}},
+		15: {"DTSTART:20200805T095958Z\nRRULE:FREQ=SECONDLY;BYHOUR=10;BYSECOND=0,30;COUNT=4", []string{
+			"2020-08-05T09:59:58Z", "2020-08-05T10:00:00Z", "2020-08-05T10:00:30Z", "2020-08-05T10:01:00Z",
+		}},
+		16: {"DTSTART:20200131T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", []string{
+			"2020-01-31T09:00:00Z", "2020-02-29T09:00:00Z", "2020-03-31T09:00:00Z",
+		}},
+		17: {"DTSTART:20200101T090000Z\nRRULE:FREQ=YEARLY;BYYEARDAY=1,-1;BYMONTH=12;COUNT=3", []string{
+			"2020-01-01T09:00:00Z", "2020-12-31T09:00:00Z", "2021-12-31T09:00:00Z",
+		}},
+		18: {"DTSTART:20200805T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYDAY=-1MO;COUNT=3", []string{
+			"2020-08-05T09:00:00Z", "2021-02-22T09:00:00Z", "2022-02-28T09:00:00Z",
+		}},
+	}
+
+	for index, test := range tests {
+		r := ParseRecurrence(test.input, UTC)
+		assert.Nil(r.Error, "Current test index is "+strconv.Itoa(index))
+		assert.Equal(test.expected, toRecurrenceStrings(r.Between(start, end)), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestRecurrence_Between(t *testing.T) {
+	assert := assert.New(t)
+
+	r := Parse("2020-08-05 13:14:15", UTC).Recurrence("FREQ=WEEKLY;INTERVAL=2;BYDAY=TU")
+	assert.Nil(r.Error)
+	assert.Equal([]string{
+		"2020-08-18T13:14:15Z", "2020-09-01T13:14:15Z", "2020-09-15T13:14:15Z",
+	}, toRecurrenceStrings(r.Between(Parse("2020-08-06", UTC), Parse("2020-09-15 13:14:15", UTC))))
+	assert.Equal([]string{"2020-08-05T13:14:15Z"}, toRecurrenceStrings(r.Between(Parse("2020-08-05 13:14:15", UTC), Parse("2020-08-17", UTC))))
+	assert.Empty(r.Between(Parse("2020-08-06", UTC), Parse("2020-08-05", UTC)))
+	assert.Empty(r.Between(Parse(""), Parse("2020-08-05", UTC)))
+}
+
+func TestRecurrence_After(t *testing.T) {
+	assert := assert.New(t)
+
+	r := Parse("2020-08-05 13:14:15", UTC).Recurrence("FREQ=MONTHLY;COUNT=3")
+	assert.Equal("2020-08-05 13:14:15", r.After(Parse("2020-08-01", UTC)).ToDateTimeString())
+	assert.Equal("2020-09-05 13:14:15", r.After(Parse("2020-08-05 13:14:15", UTC)).ToDateTimeString())
+	assert.Equal("UTC", r.After(Parse("2020-08-05 13:14:15", UTC)).Location())
+	assert.True(r.After(Parse("2020-10-05 13:14:15", UTC)).IsInvalid())
+	assert.True(r.After(Parse("")).IsInvalid())
+}
+
+func TestRecurrence_Before(t *testing.T) {
+	assert := assert.New(t)
+
+	r := Parse("2020-08-05 13:14:15", UTC).Recurrence("FREQ=MONTHLY")
+	assert.Equal("2021-08-05 13:14:15", r.Before(Parse("2021-08-06", UTC)).ToDateTimeString())
+	assert.Equal("2021-07-05 13:14:15", r.Before(Parse("2021-08-05 13:14:15", UTC)).ToDateTimeString())
+	assert.True(r.Before(Parse("2020-08-05 13:14:15", UTC)).IsInvalid())
+	assert.True(r.Before(Parse("")).IsInvalid())
+}
+
+func TestRecurrence_Count(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    Recurrence
+		expected int
+	}{
+		0: {Parse("").Recurrence("FREQ=DAILY;COUNT=3"), 0},
+		1: {Parse("2020-08-05").Recurrence(), 1},
+		2: {Parse("2020-08-05").Recurrence("FREQ=DAILY"), -1},
+		3: {Parse("2020-08-05").Recurrence("FREQ=DAILY;COUNT=10"), 10},
+		4: {Parse("2020-08-05").Recurrence("FREQ=DAILY;COUNT=10").AddExRule("FREQ=WEEKLY"), 8},
+		5: {Parse("2020-08-05").Recurrence("FREQ=DAILY;COUNT=10").AddExDates(Parse("2020-08-06")), 9},
+		6: {Parse("2020-08-05").Recurrence("FREQ=DAILY;COUNT=10").AddRDates(Parse("2020-08-06"), Parse("2020-09-01")), 11},
+		7: {Parse("2020-08-05").Recurrence("FREQ=DAILY;COUNT=10", "FREQ=WEEKLY;COUNT=3"), 11},
+		8: {Parse("2020-08-05").Recurrence("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30"), -1},
+		9: {Parse("2020-08-05").Recurrence("FREQ=YEARLY;UNTIL=20300101"), 10},
+	}
+
+	for index, test := range tests {
+		assert.Equal(test.expected, test.input.Count(), "Current test index is "+strconv.Itoa(index))
+	}
+
+	// an impossible rule stops at the max year
+	r := Parse("2020-08-05", UTC).Recurrence("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	assert.True(r.After(Parse("2020-08-05", UTC)).IsInvalid())
+}
+
+func TestRecurrence_ForEach(t *testing.T) {
+	assert := assert.New(t)
+
+	r := Parse("2020-08-05", UTC).Recurrence("FREQ=DAILY")
+	days := make([]string, 0)
+	r.ForEach(func(c Carbon) bool {
+		days = append(days, c.ToDateString())
+		return len(days) < 3
+	})
+	assert.Equal([]string{"2020-08-05", "2020-08-06", "2020-08-07"}, days)
+	assert.Equal("2020-08-05", r.Start().ToDateString())
+}
+
+func TestRecurrence_String(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		0: {"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:freq=weekly;interval=2;wkst=su;byday=tu,th;count=8",
+			"DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=8;BYDAY=TU,TH;WKST=SU"},
+		1: {"DTSTART:19970902T090000Z\nRRULE:FREQ=MONTHLY;BYSETPOS=-1;BYDAY=MO,TU,WE,TH,FR;UNTIL=19971224T000000Z\nEXDATE:19970930T090000Z",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=MONTHLY;UNTIL=19971224T000000Z;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1\nEXDATE:19970930T090000Z"},
+		2: {"DTSTART;VALUE=DATE:20200805\r\nRRULE:FREQ=YEARLY;BYMONTH=1,2;BYDAY=-1\r\n\tMO;BYHOUR=1;BYMINUTE=2;BYSECOND=3\nRDATE;VALUE=DATE:20200806",
+			"DTSTART;VALUE=DATE:20200805\nRRULE:FREQ=YEARLY;BYMONTH=1,2;BYDAY=-1MO;BYHOUR=1;BYMINUTE=2;BYSECOND=3\nRDATE;VALUE=DATE:20200806"},
+		3: {"DTSTART:20200805T131415\nEXRULE:FREQ=DAILY;BYYEARDAY=1;BYMONTHDAY=2", ""},
+		4: {"DTSTART:20200805T131415\nRRULE:FREQ=YEARLY;BYWEEKNO=1,-1;BYYEARDAY=-1;BYMONTHDAY=31",
+			"DTSTART:20200805T131415Z\nRRULE:FREQ=YEARLY;BYWEEKNO=1,-1;BYYEARDAY=-1;BYMONTHDAY=31"},
+	}
+
+	for index, test := range tests {
+		r := ParseRecurrence(test.input, UTC)
+		assert.Equal(test.expected, r.String(), "Current test index is "+strconv.Itoa(index))
+		if test.expected != "" {
+			assert.Equal(test.expected, ParseRecurrence(r.String(), UTC).String(), "Current test index is "+strconv.Itoa(index))
+		}
+	}
+}
+
+func TestError_Recurrence(t *testing.T) {
+	rules := []string{
+		"", "RRULE:", "FREQ", "FREQ=xxx", "INTERVAL=2", "FREQ=DAILY;INTERVAL=0", "FREQ=DAILY;COUNT=0", "FREQ=DAILY;COUNT=1;UNTIL=20200805",
+		"FREQ=DAILY;UNTIL=xxx", "FREQ=DAILY;WKST=xx", "FREQ=DAILY;WKST=1MO", "FREQ=DAILY;BYSECOND=61", "FREQ=DAILY;BYMINUTE=60", "FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYDAY=X", "FREQ=DAILY;BYDAY=0MO", "FREQ=DAILY;BYDAY=1MO", "FREQ=MONTHLY;BYDAY=54MO", "FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=MONTHLY;BYMONTHDAY=32", "FREQ=YEARLY;BYYEARDAY=367", "FREQ=YEARLY;BYWEEKNO=54", "FREQ=YEARLY;BYMONTH=13", "FREQ=YEARLY;BYSETPOS=1",
+		"FREQ=MONTHLY;BYWEEKNO=1", "FREQ=MONTHLY;BYYEARDAY=1", "FREQ=WEEKLY;BYMONTHDAY=1", "FREQ=YEARLY;BYWEEKNO=1;BYDAY=1MO", "FREQ=DAILY;XXX=1",
+	}
+	for index, rule := range rules {
+		assert.NotNil(t, Now().Recurrence(rule).Error, "It should catch an exception in AddRRule(), current test index is "+strconv.Itoa(index))
+		assert.NotNil(t, NewRecurrence(Now()).AddExRule(rule).Error, "It should catch an exception in AddExRule(), current test index is "+strconv.Itoa(index))
+	}
+
+	values := []string{
+		"", "RRULE:FREQ=DAILY", "DTSTART:xxx", "DTSTART;TZID=xxx:20200805T131415", "DTSTART:20200805T131415\nRDATE:xxx",
+		"DTSTART:20200805T131415\nRDATE;VALUE=PERIOD:20200805T131415/PT1H", "DTSTART:20200805T131415\nXXX:1", "DTSTART:xxxZ",
+	}
+	for index, value := range values {
+		assert.NotNil(t, ParseRecurrence(value).Error, "It should catch an exception in ParseRecurrence(), current test index is "+strconv.Itoa(index))
+	}
+	assert.NotNil(t, ParseRecurrence("DTSTART:20200805T131415", "xxx").Error, "It should catch an exception in ParseRecurrence()")
+	assert.NotNil(t, NewRecurrence(Parse("xxx")).Error, "It should catch an exception in NewRecurrence()")
+	assert.NotNil(t, NewRecurrence(Parse("")).Error, "It should catch an exception in NewRecurrence()")
+	assert.NotNil(t, NewRecurrence(Now()).AddRDates(Parse("xxx")).Error, "It should catch an exception in AddRDates()")
+	assert.NotNil(t, NewRecurrence(Now()).AddExDates(Parse("")).Error, "It should catch an exception in AddExDates()")
+	assert.Equal(t, "", NewRecurrence(Parse("")).String())
+}
@@ -78,6 +78,20 @@ func SetLanguage(lang *Language) Carbon {
 	return c
 }
 
+// SetStrict sets whether to parse time strings strictly, the strict mode rejects non-numeric timestamps,
+// fractional seconds which are not in the layout and relative expressions, and returns a *ParseError.
+// 设置是否严格解析时间字符串
+func (c Carbon) SetStrict(strict bool) Carbon {
+	c.strict = strict
+	return c
+}
+
+// SetStrict sets whether to parse time strings strictly.
+// 设置是否严格解析时间字符串
+func SetStrict(strict bool) Carbon {
+	return NewCarbon().SetStrict(strict)
+}
+
 // SetDateTime sets year, month, day, hour, minute and second.
 // 设置年、月、日、时、分、秒
 func (c Carbon) SetDateTime(year, month, day, hour, minute, second int) Carbon {
@@ -257,6 +271,57 @@ func (c Carbon) SetWeekStartsAt(day string) Carbon {
 	return c
 }
 
+// SetWeekendDays sets weekend days, Saturday and Sunday are weekend days by default.
+// 设置周末，默认周六和周日为周末
+func (c Carbon) SetWeekendDays(days ...string) Carbon {
+	if c.IsInvalid() {
+		return c
+	}
+	// the highest bit marks that the weekend days have been customized
+	c.weekendDays = 1 << 7
+	for _, day := range days {
+		switch day {
+		case Sunday:
+			c.weekendDays |= 1 << uint(time.Sunday)
+		case Monday:
+			c.weekendDays |= 1 << uint(time.Monday)
+		case Tuesday:
+			c.weekendDays |= 1 << uint(time.Tuesday)
+		case Wednesday:
+			c.weekendDays |= 1 << uint(time.Wednesday)
+		case Thursday:
+			c.weekendDays |= 1 << uint(time.Thursday)
+		case Friday:
+			c.weekendDays |= 1 << uint(time.Friday)
+		case Saturday:
+			c.weekendDays |= 1 << uint(time.Saturday)
+		}
+	}
+	return c
+}
+
+// SetHolidayProvider sets holiday provider.
+// 设置节假日提供者
+func (c Carbon) SetHolidayProvider(provider HolidayProvider) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	c.holidays = provider
+	return c
+}
+
+// SetHolidayRegion sets the built-in holiday rules of the region like "us" or "gb" as holiday provider.
+// 设置区域内置的节假日规则为节假日提供者
+func (c Carbon) SetHolidayRegion(region string) Carbon {
+	if c.Error != nil {
+		return c
+	}
+	calendar := NewHolidayCalendar()
+	calendar.SetRegion(region)
+	c.holidays, c.Error = calendar, calendar.Error
+	return c
+}
+
 // SetDay sets day.
 // 设置日期
 func (c Carbon) SetDay(day int) Carbon {
@@ -174,3 +174,25 @@ func BenchmarkCarbon_SetNanosecond(b *testing.B) {
 		c.SetNanosecond(20)
 	}
 }
+
+func BenchmarkCarbon_SetWeekendDays(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.SetWeekendDays(Friday, Saturday)
+	}
+}
+
+func BenchmarkCarbon_SetHolidayProvider(b *testing.B) {
+	now := Now()
+	provider := HolidayFunc(func(c Carbon) bool { return false })
+	for n := 0; n < b.N; n++ {
+		now.SetHolidayProvider(provider)
+	}
+}
+
+func BenchmarkCarbon_SetHolidayRegion(b *testing.B) {
+	now := Now()
+	for n := 0; n < b.N; n++ {
+		now.SetHolidayRegion("us")
+	}
+}
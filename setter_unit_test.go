@@ -659,3 +659,50 @@ func TestError_Setter(t *testing.T) {
 	assert.NotNil(t, c.SetMicrosecond(microsecond).Error, "It should catch an exception in SetMicrosecond()")
 	assert.NotNil(t, c.SetNanosecond(nanosecond).Error, "It should catch an exception in SetNanosecond()")
 }
+
+func TestCarbon_SetWeekendDays(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		input     string
+		days      []string
+		isWeekend bool
+	}{
+		0: {"", []string{Friday}, false},
+		1: {"2020-08-07", []string{Friday}, true},
+		2: {"2020-08-08", []string{Friday}, false},
+		3: {"2020-08-08", []string{Friday, Saturday}, true},
+		4: {"2020-08-09", []string{}, false},
+		5: {"2020-08-09", []string{"xxx"}, false},
+	}
+
+	for index, test := range tests {
+		c := Parse(test.input).SetWeekendDays(test.days...)
+		assert.Nil(c.Error)
+		assert.Equal(test.isWeekend, c.IsWeekend(), "Current test index is "+strconv.Itoa(index))
+		assert.Equal(!test.isWeekend && c.IsValid(), c.IsWeekday(), "Current test index is "+strconv.Itoa(index))
+	}
+}
+
+func TestCarbon_SetHolidayProvider(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Parse("2020-10-01").SetHolidayProvider(HolidayFunc(func(c Carbon) bool {
+		return c.Month() == 10 && c.Day() == 1
+	}))
+	assert.Nil(c.Error)
+	assert.False(c.IsBusinessDay())
+	assert.True(c.AddDay().IsBusinessDay())
+	assert.NotNil(Parse("xxx").SetHolidayProvider(nil).Error, "It should catch an exception in SetHolidayProvider()")
+}
+
+func TestCarbon_SetHolidayRegion(t *testing.T) {
+	assert := assert.New(t)
+
+	c := Parse("2020-11-26").SetHolidayRegion("us")
+	assert.Nil(c.Error)
+	assert.True(c.IsHoliday())
+	assert.False(c.IsBusinessDay())
+	assert.Equal("2020-11-27", c.NextBusinessDay().ToDateString())
+	assert.NotNil(Parse("xxx").SetHolidayRegion("us").Error, "It should catch an exception in SetHolidayRegion()")
+}
@@ -10,13 +10,13 @@ var (
 	// invalid pointer error
 	// 无效的指针错误
 	invalidPtrError = func() error {
-		return fmt.Errorf("invalid struct pointer, please make sure the struct is a pointer")
+		return fmt.Errorf("%w, please make sure the struct is a pointer", ErrInvalidTag)
 	}
 
 	// invalid tag error
 	// 无效的标签错误
-	invalidTagError = func() error {
-		return fmt.Errorf("invalid carbon tag, please make sure the tag is valid")
+	invalidTagError = func(field, tag string) error {
+		return &TagError{Field: field, Tag: tag}
 	}
 )
 
@@ -171,7 +171,7 @@ func LoadTag(v interface{}) error {
 		}
 
 		if !strings.Contains(carbonTag, "layout:") && !strings.Contains(carbonTag, "format:") {
-			return invalidTagError()
+			return invalidTagError(field.Name, field.Tag.Get("carbon"))
 		}
 
 		tzTag := field.Tag.Get("tz")
@@ -84,7 +84,12 @@ func (c Carbon) AddDuration(duration string) Carbon {
 // SubDuration subtracts one duration.
 // 按照时长减少时间,支持整数/浮点数和符号ns(纳秒)、us(微妙)、ms(毫秒)、s(秒)、m(分钟)、h(小时)的组合
 func (c Carbon) SubDuration(duration string) Carbon {
-	return c.AddDuration("-" + duration)
+	if c.IsInvalid() {
+		return c
+	}
+	td, err := parseByDuration(duration)
+	c.time, c.Error = c.ToStdTime().Add(-td), err
+	return c
 }
 
 // AddCenturies adds some centuries.